@@ -0,0 +1,136 @@
+package semver
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// BigVersion is a semantic version whose major, minor and patch versions are
+// arbitrary precision integers.
+//
+// The semver spec places no upper limit on these, and some ecosystems use huge
+// date-like components (e.g. "20240101120000.0.0") that overflow a uint. Most
+// code should use [Version], BigVersion exists for those that really need it.
+//
+// A nil numeric component is treated as 0.
+type BigVersion struct {
+	Major      *big.Int // Major version
+	Minor      *big.Int // Minor version
+	Patch      *big.Int // Patch version
+	Prerelease string   // Optional pre-release e.g. "rc1"
+	Build      string   // Optional build metadata e.g. "build.123"
+}
+
+// ParseBig creates and returns a BigVersion from a semver string.
+//
+// It accepts exactly the same syntax as [Parse] but never overflows.
+//
+//	v, _ := ParseBig("99999999999999999999.0.0")
+//	fmt.Println(v) // "99999999999999999999.0.0"
+func ParseBig(text string) (BigVersion, error) {
+	c, err := scan(text)
+	if err.failed() {
+		return BigVersion{}, newParseError(text, err)
+	}
+
+	// The scanner guarantees these are all decimal digits, so SetString cannot fail
+	majorInt, _ := new(big.Int).SetString(c.major, 10)
+	minorInt, _ := new(big.Int).SetString(c.minor, 10)
+	patchInt, _ := new(big.Int).SetString(c.patch, 10)
+
+	v := BigVersion{
+		Major:      majorInt,
+		Minor:      minorInt,
+		Patch:      patchInt,
+		Prerelease: c.pre,
+		Build:      c.build,
+	}
+
+	return v, nil
+}
+
+// String implements the Stringer interface and allows a BigVersion to print itself.
+//
+//	v := BigVersion{Major: big.NewInt(1), Minor: big.NewInt(2), Patch: big.NewInt(3)}
+//	fmt.Println(v) // "1.2.3"
+func (v BigVersion) String() string {
+	base := orZero(v.Major).String() + "." + orZero(v.Minor).String() + "." + orZero(v.Patch).String()
+	if v.Prerelease != "" {
+		base += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		base += "+" + v.Build
+	}
+	return base
+}
+
+// Tag creates a string representation of the version suitable for git tags
+// it is identical to the String() method except prepends a 'v' to the result.
+func (v BigVersion) Tag() string {
+	return "v" + v.String()
+}
+
+// Version converts v to a [Version].
+//
+// If any of the major, minor or patch versions do not fit in a uint, or are
+// negative, an error wrapping [ErrOverflow] is returned.
+func (v BigVersion) Version() (Version, error) {
+	parts := [...]*big.Int{orZero(v.Major), orZero(v.Minor), orZero(v.Patch)}
+	names := [...]string{major, minor, patch}
+
+	var nums [3]uint
+	for i, part := range parts {
+		if part.Sign() < 0 || !part.IsUint64() || part.Uint64() > math.MaxUint {
+			return Version{}, fmt.Errorf("%s: %s version %s: %w", v, names[i], part, ErrOverflow)
+		}
+		nums[i] = uint(part.Uint64())
+	}
+
+	version := Version{
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+	}
+
+	return version, nil
+}
+
+// Big converts v to a [BigVersion].
+func (v Version) Big() BigVersion {
+	return BigVersion{
+		Major:      new(big.Int).SetUint64(uint64(v.Major)),
+		Minor:      new(big.Int).SetUint64(uint64(v.Minor)),
+		Patch:      new(big.Int).SetUint64(uint64(v.Patch)),
+		Prerelease: v.Prerelease,
+		Build:      v.Build,
+	}
+}
+
+// CompareBig is the [BigVersion] equivalent of [Compare], returning -1, 0 or +1
+// according to semver precedence.
+func CompareBig(a, b BigVersion) int {
+	if c := orZero(a.Major).Cmp(orZero(b.Major)); c != 0 {
+		return c
+	}
+	if c := orZero(a.Minor).Cmp(orZero(b.Minor)); c != 0 {
+		return c
+	}
+	if c := orZero(a.Patch).Cmp(orZero(b.Patch)); c != 0 {
+		return c
+	}
+	return comparePrerelease(a.Prerelease, b.Prerelease)
+}
+
+// zero is a shared 0, it must never be modified.
+var zero = new(big.Int)
+
+// orZero returns n, or zero if n is nil.
+func orZero(n *big.Int) *big.Int {
+	if n == nil {
+		return zero
+	}
+	return n
+}
@@ -0,0 +1,160 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestParseBig(t *testing.T) {
+	tests := []struct {
+		text    string
+		major   string
+		minor   string
+		patch   string
+		pre     string
+		build   string
+		wantErr bool
+	}{
+		{text: "1.2.3", major: "1", minor: "2", patch: "3"},
+		{text: "v1.2.3-rc.1+build.123", major: "1", minor: "2", patch: "3", pre: "rc.1", build: "build.123"},
+		{text: "20240101120000000000000.0.0", major: "20240101120000000000000", minor: "0", patch: "0"},
+		{text: "1.99999999999999999999999.3-beta", major: "1", minor: "99999999999999999999999", patch: "3", pre: "beta"},
+		{text: "01.2.3", wantErr: true},
+		{text: "1.2", wantErr: true},
+		{text: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := semver.ParseBig(tt.text)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseBig(%q) returned an unexpected error: %v", tt.text, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got.Major.String() != tt.major || got.Minor.String() != tt.minor || got.Patch.String() != tt.patch {
+				t.Errorf("got %s.%s.%s, wanted %s.%s.%s", got.Major, got.Minor, got.Patch, tt.major, tt.minor, tt.patch)
+			}
+
+			if got.Prerelease != tt.pre {
+				t.Errorf("got Prerelease %q, wanted %q", got.Prerelease, tt.pre)
+			}
+
+			if got.Build != tt.build {
+				t.Errorf("got Build %q, wanted %q", got.Build, tt.build)
+			}
+		})
+	}
+}
+
+func TestBigVersionString(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    string
+		version semver.BigVersion
+	}{
+		{
+			name:    "empty",
+			version: semver.BigVersion{},
+			want:    "0.0.0",
+		},
+		{
+			name:    "just version",
+			version: semver.BigVersion{Major: big.NewInt(1), Minor: big.NewInt(6), Patch: big.NewInt(12)},
+			want:    "1.6.12",
+		},
+		{
+			name:    "prerelease and build",
+			version: semver.BigVersion{Major: big.NewInt(1), Patch: big.NewInt(12), Prerelease: "rc.1", Build: "build.123"},
+			want:    "1.0.12-rc.1+build.123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.version.String(); got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+
+			if got := tt.version.Tag(); got != "v"+tt.want {
+				t.Errorf("got %q, wanted %q", got, "v"+tt.want)
+			}
+		})
+	}
+}
+
+func TestBigVersionConversion(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.123"}
+
+	got, err := v.Big().Version()
+	if err != nil {
+		t.Fatalf("Version returned an unexpected error: %v", err)
+	}
+
+	if got != v {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, v)
+	}
+
+	huge, err := semver.ParseBig("99999999999999999999.0.0")
+	if err != nil {
+		t.Fatalf("ParseBig returned an error: %v", err)
+	}
+
+	_, err = huge.Version()
+	if !errors.Is(err, semver.ErrOverflow) {
+		t.Errorf("Version did not return ErrOverflow, got %v", err)
+	}
+}
+
+func TestCompareBig(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "1.2.3", b: "1.2.3", want: 0},
+		{a: "1.2.3", b: "99999999999999999999.0.0", want: -1},
+		{a: "1.99999999999999999999.0", b: "1.99999999999999999998.0", want: 1},
+		{a: "1.2.3-rc.1", b: "1.2.3", want: -1},
+		{a: "1.2.3+build", b: "1.2.3", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" "+tt.b, func(t *testing.T) {
+			a, err := semver.ParseBig(tt.a)
+			if err != nil {
+				t.Fatalf("ParseBig(%q) returned an error: %v", tt.a, err)
+			}
+			b, err := semver.ParseBig(tt.b)
+			if err != nil {
+				t.Fatalf("ParseBig(%q) returned an error: %v", tt.b, err)
+			}
+
+			if got := semver.CompareBig(a, b); got != tt.want {
+				t.Errorf("CompareBig(%s, %s): got %d, wanted %d", a, b, got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleParseBig() {
+	_, err := semver.Parse("20240101120000000000.0.0")
+	fmt.Println(errors.Is(err, semver.ErrOverflow))
+
+	v, err := semver.ParseBig("20240101120000000000.0.0")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v)
+	// Output:
+	// true
+	// 20240101120000000000.0.0
+}
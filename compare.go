@@ -0,0 +1,174 @@
+package semver
+
+import (
+	"cmp"
+	"strings"
+)
+
+// Compare returns an integer comparing two versions according to the
+// precedence rules laid out in [semver 2.0.0 spec §11].
+//
+// The result will be 0 if a == b, -1 if a < b, and +1 if a > b. Build metadata
+// is ignored when determining precedence, so two versions differing only in
+// their build metadata compare equal.
+//
+// Compare has the right signature to be passed straight to [slices.SortFunc].
+//
+//	a, _ := Parse("1.0.0-rc.1")
+//	b, _ := Parse("1.0.0")
+//	Compare(a, b) // -1
+//
+// [semver 2.0.0 spec §11]: https://semver.org/#spec-item-11
+func Compare(a, b Version) int {
+	if c := cmp.Compare(a.Major, b.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Minor, b.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(a.Patch, b.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(a.Prerelease, b.Prerelease)
+}
+
+// Less reports whether v has lower precedence than other.
+func (v Version) Less(other Version) bool {
+	return Compare(v, other) < 0
+}
+
+// Equal reports whether v and other have equal precedence.
+//
+// Note this is not the same as v == other, as build metadata does not
+// contribute to precedence.
+func (v Version) Equal(other Version) bool {
+	return Compare(v, other) == 0
+}
+
+// Compatible reports whether a and b are API compatible according to [semver 2.0.0 spec §4]
+// and §8, using the same rules as Cargo's default (caret) requirements.
+//
+// Versions with a major version of 1 or above are compatible if they share it, so "1.3.0"
+// and "1.9.2" are compatible. Anything in 0.y.z is unstable, so compatibility falls to the
+// left-most non-zero component: "0.3.1" and "0.3.9" are compatible but "0.3.1" and "0.4.0"
+// are not, and "0.0.z" versions are only compatible with themselves.
+//
+// Pre-releases are unstable too, so a pre-release is only compatible with versions of the
+// same major.minor.patch: "2.0.0-rc.1" is compatible with "2.0.0" but not with "2.1.0".
+// Build metadata is ignored.
+//
+// Compatible is symmetric, Compatible(a, b) == Compatible(b, a).
+//
+// [semver 2.0.0 spec §4]: https://semver.org/#spec-item-4
+func Compatible(a, b Version) bool {
+	if (a.Prerelease != "" || b.Prerelease != "") && a.Release() != b.Release() {
+		return false
+	}
+
+	switch {
+	case a.Major != b.Major:
+		return false
+	case a.Major > 0:
+		return true
+	case a.Minor != b.Minor:
+		return false
+	case a.Minor > 0:
+		return true
+	default:
+		return a.Patch == b.Patch
+	}
+}
+
+// IsStable reports whether v is a stable release, that is it has a major
+// version of 1 or above and is not a pre-release.
+//
+// Per [semver 2.0.0 spec §4], anything in 0.y.z is for initial development and
+// may change at any time, and per §9 a pre-release might not satisfy the
+// compatibility requirements of its associated normal version.
+//
+//	Version{Major: 1, Minor: 4}.IsStable()           // true
+//	Version{Minor: 4}.IsStable()                     // false
+//	Version{Major: 2, Prerelease: "rc.1"}.IsStable() // false
+//
+// [semver 2.0.0 spec §4]: https://semver.org/#spec-item-4
+func (v Version) IsStable() bool {
+	return v.Major > 0 && v.Prerelease == ""
+}
+
+// comparePrerelease compares two pre-release strings by precedence.
+//
+// A version without a pre-release has higher precedence than one with, otherwise
+// each dot separated identifier is compared from left to right until a difference
+// is found. If all shared identifiers are equal, the longer set wins.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	for a != "" && b != "" {
+		var idA, idB string
+		idA, a, _ = strings.Cut(a, ".")
+		idB, b, _ = strings.Cut(b, ".")
+
+		if c := compareIdentifier(idA, idB); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case a == "" && b == "":
+		return 0
+	case a == "":
+		return -1
+	default:
+		return 1
+	}
+}
+
+// compareIdentifier compares a single pair of pre-release identifiers.
+//
+// Numeric identifiers are compared numerically and always have lower precedence
+// than alphanumeric ones, which are compared lexically in ASCII sort order.
+func compareIdentifier(a, b string) int {
+	numA, numB := isNumeric(a), isNumeric(b)
+
+	switch {
+	case numA && numB:
+		// Numeric identifiers may be arbitrarily large so rather than parse them
+		// compare them as digit strings, the longer one being the larger number
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if c := cmp.Compare(len(a), len(b)); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case numA:
+		return -1
+	case numB:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// isNumeric reports whether s is made up entirely of ASCII digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := range len(s) {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigit reports whether c is an ASCII digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,222 @@
+package semver_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "equal", a: "1.2.3", b: "1.2.3", want: 0},
+		{name: "prefix irrelevant", a: "v1.2.3", b: "1.2.3", want: 0},
+		{name: "major less", a: "1.2.3", b: "2.0.0", want: -1},
+		{name: "major greater", a: "10.0.0", b: "9.99.99", want: 1},
+		{name: "minor less", a: "1.2.3", b: "1.10.0", want: -1},
+		{name: "minor greater", a: "1.3.0", b: "1.2.99", want: 1},
+		{name: "patch less", a: "1.2.3", b: "1.2.4", want: -1},
+		{name: "patch greater", a: "1.2.10", b: "1.2.9", want: 1},
+		{name: "prerelease lower than release", a: "1.0.0-rc.1", b: "1.0.0", want: -1},
+		{name: "release higher than prerelease", a: "1.0.0", b: "1.0.0-rc.1", want: 1},
+		{name: "numeric identifiers numerically", a: "1.0.0-beta.2", b: "1.0.0-beta.11", want: -1},
+		{name: "alphanumeric identifiers lexically", a: "1.0.0-alpha", b: "1.0.0-beta", want: -1},
+		{name: "ascii sort order", a: "1.0.0-Zeta", b: "1.0.0-alpha", want: -1},
+		{name: "numeric lower than alphanumeric", a: "1.0.0-alpha.1", b: "1.0.0-alpha.beta", want: -1},
+		{name: "alphanumeric higher than numeric", a: "1.0.0-alpha.beta", b: "1.0.0-alpha.1", want: 1},
+		{name: "digits with letters are alphanumeric", a: "1.0.0-1a", b: "1.0.0-2", want: 1},
+		{name: "shorter set lower", a: "1.0.0-alpha", b: "1.0.0-alpha.1", want: -1},
+		{name: "longer set higher", a: "1.0.0-alpha.1.2", b: "1.0.0-alpha.1", want: 1},
+		{name: "huge numeric identifiers", a: "1.0.0-rc.99999999999999999999998", b: "1.0.0-rc.99999999999999999999999", want: -1},
+		{name: "build ignored", a: "1.0.0+build.1", b: "1.0.0+build.2", want: 0},
+		{name: "build ignored with prerelease", a: "1.0.0-rc.1+abc", b: "1.0.0-rc.1", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := semver.Parse(tt.a)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.a, err)
+			}
+			b, err := semver.Parse(tt.b)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.b, err)
+			}
+
+			if got := semver.Compare(a, b); got != tt.want {
+				t.Errorf("Compare(%s, %s): got %d, wanted %d", a, b, got, tt.want)
+			}
+
+			// Compare must be antisymmetric
+			if got := semver.Compare(b, a); got != -tt.want {
+				t.Errorf("Compare(%s, %s): got %d, wanted %d", b, a, got, -tt.want)
+			}
+
+			if got := a.Less(b); got != (tt.want < 0) {
+				t.Errorf("%s.Less(%s): got %v, wanted %v", a, b, got, tt.want < 0)
+			}
+
+			if got := a.Equal(b); got != (tt.want == 0) {
+				t.Errorf("%s.Equal(%s): got %v, wanted %v", a, b, got, tt.want == 0)
+			}
+		})
+	}
+}
+
+func TestCompareSpecOrder(t *testing.T) {
+	// The example ordering given in https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"2.0.0",
+		"2.1.0",
+		"2.1.1",
+	}
+
+	versions := make([]semver.Version, 0, len(ordered))
+	for _, str := range ordered {
+		v, err := semver.Parse(str)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned an error: %v", str, err)
+		}
+		versions = append(versions, v)
+	}
+
+	shuffled := slices.Clone(versions)
+	slices.Reverse(shuffled)
+	slices.SortFunc(shuffled, semver.Compare)
+
+	if !slices.Equal(shuffled, versions) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", shuffled, versions)
+	}
+}
+
+func TestCompatible(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "equal", a: "1.2.3", b: "1.2.3", want: true},
+		{name: "same major", a: "1.3.0", b: "1.9.2", want: true},
+		{name: "different major", a: "1.9.2", b: "2.0.0", want: false},
+		{name: "build ignored", a: "1.2.3+a", b: "1.4.0+b", want: true},
+		{name: "zero major same minor", a: "0.3.1", b: "0.3.9", want: true},
+		{name: "zero major different minor", a: "0.3.1", b: "0.4.0", want: false},
+		{name: "zero major vs one", a: "0.9.0", b: "1.0.0", want: false},
+		{name: "zero minor same patch", a: "0.0.3", b: "0.0.3", want: true},
+		{name: "zero minor different patch", a: "0.0.3", b: "0.0.4", want: false},
+		{name: "zero minor vs non-zero minor", a: "0.0.3", b: "0.1.3", want: false},
+		{name: "prerelease of same version", a: "2.0.0-rc.1", b: "2.0.0", want: true},
+		{name: "prereleases of same version", a: "2.0.0-rc.1", b: "2.0.0-beta", want: true},
+		{name: "prerelease of later minor", a: "1.2.0", b: "1.3.0-beta", want: false},
+		{name: "prerelease of different major", a: "2.0.0-rc.1", b: "1.9.0", want: false},
+		{name: "zero prerelease", a: "0.3.0-alpha", b: "0.3.0", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, err := semver.Parse(tt.a)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.a, err)
+			}
+
+			b, err := semver.Parse(tt.b)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.b, err)
+			}
+
+			if got := semver.Compatible(a, b); got != tt.want {
+				t.Errorf("Compatible(%s, %s): got %v, wanted %v", a, b, got, tt.want)
+			}
+
+			if got := semver.Compatible(b, a); got != tt.want {
+				t.Errorf("Compatible(%s, %s): got %v, wanted %v", b, a, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsStable(t *testing.T) {
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{version: "0.0.0", want: false},
+		{version: "0.1.0", want: false},
+		{version: "0.9.9+build.1", want: false},
+		{version: "1.0.0", want: true},
+		{version: "1.0.0+build.1", want: true},
+		{version: "1.0.0-rc.1", want: false},
+		{version: "12.3.4", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			v, err := semver.Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.version, err)
+			}
+
+			if got := v.IsStable(); got != tt.want {
+				t.Errorf("got %v, wanted %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	x := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha.beta.12"}
+	y := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha.beta.2"}
+
+	for b.Loop() {
+		_ = semver.Compare(x, y)
+	}
+}
+
+func ExampleCompare() {
+	versions := []semver.Version{
+		{Major: 1, Minor: 0, Patch: 0},
+		{Major: 1, Minor: 0, Patch: 0, Prerelease: "rc.1"},
+		{Major: 0, Minor: 9, Patch: 12},
+		{Major: 1, Minor: 0, Patch: 0, Prerelease: "beta.11"},
+		{Major: 1, Minor: 0, Patch: 0, Prerelease: "beta.2"},
+	}
+
+	slices.SortFunc(versions, semver.Compare)
+
+	for _, version := range versions {
+		fmt.Println(version)
+	}
+	// Output:
+	// 0.9.12
+	// 1.0.0-beta.2
+	// 1.0.0-beta.11
+	// 1.0.0-rc.1
+	// 1.0.0
+}
+
+func ExampleCompatible() {
+	host := semver.Version{Major: 1, Minor: 3}
+
+	for _, plugin := range []semver.Version{{Major: 1, Minor: 9, Patch: 2}, {Major: 2}} {
+		fmt.Printf("%s with host %s: %v\n", plugin, host, semver.Compatible(host, plugin))
+	}
+
+	// Output:
+	// 1.9.2 with host 1.3.0: true
+	// 2.0.0 with host 1.3.0: false
+}
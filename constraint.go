@@ -0,0 +1,596 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Constraint is a version range expression that a [Version] can be checked against.
+//
+// The syntax follows that of npm's node-semver, the most widely understood
+// range language in the wild:
+//
+//   - Comparators: "=1.2.3", ">1.2.3", ">=1.2.3", "<1.2.3", "<=1.2.3", a bare version means "="
+//   - Space separated comparators must all be satisfied: ">=1.2.0 <2.0.0"
+//   - Clauses separated by "||" are alternatives, any one may be satisfied: "<1.0.0 || >=2.0.0"
+//   - X-ranges: "*", "1.x", "1.2.*" or simply "1" and "1.2", a missing or wildcard component matches anything
+//   - Tilde ranges: "~1.2.3" allows patch level changes, ">=1.2.3 <1.3.0"
+//   - Caret ranges: "^1.2.3" allows changes that do not modify the left-most non-zero component,
+//     ">=1.2.3 <2.0.0", "^0.2.3" is ">=0.2.3 <0.3.0" and "^0.0.3" is ">=0.0.3 <0.0.4"
+//   - Hyphen ranges: "1.2.3 - 2.3.4" is an inclusive range, ">=1.2.3 <=2.3.4"
+//
+// An empty expression is equivalent to "*".
+//
+// # Pre-releases
+//
+// Pre-release versions are treated specially, again following npm's convention. A version
+// with a pre-release only satisfies a clause if every comparator in that clause is satisfied
+// and at least one of the clause's comparators names a pre-release on the same major.minor.patch
+// tuple. This means ">=1.2.3-alpha.1" is satisfied by "1.2.3-beta" but not by "1.2.4-beta",
+// someone opting in to the pre-releases of one version has not opted in to those of every
+// later version too.
+//
+// The zero value of a Constraint is satisfied by no version, use [ParseConstraint] to create one.
+type Constraint struct {
+	expr    string   // The original expression
+	clauses []clause // The "||" separated alternatives
+}
+
+// ParseConstraint parses a version range expression into a [Constraint].
+//
+//	c, _ := ParseConstraint(">=1.2.0 <2.0.0 || ^3.1")
+//	c.Check(Version{Major: 3, Minor: 4}) // true
+func ParseConstraint(expr string) (Constraint, error) {
+	raw := strings.Split(expr, "||")
+	clauses := make([]clause, 0, len(raw))
+
+	for _, text := range raw {
+		cl, err := parseClause(text)
+		if err != nil {
+			return Constraint{}, fmt.Errorf("invalid constraint %q: %w", expr, err)
+		}
+		clauses = append(clauses, cl)
+	}
+
+	return Constraint{expr: strings.TrimSpace(expr), clauses: clauses}, nil
+}
+
+// String returns the expression the Constraint was parsed from.
+func (c Constraint) String() string {
+	return c.expr
+}
+
+// Check reports whether v satisfies the Constraint.
+func (c Constraint) Check(v Version) bool {
+	for _, cl := range c.clauses {
+		if cl.check(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Satisfies reports whether v satisfies the [Constraint] c.
+//
+// It is equivalent to c.Check(v).
+func (v Version) Satisfies(c Constraint) bool {
+	return c.Check(v)
+}
+
+// Explain describes why v does not satisfy the Constraint, returning a [Failure]
+// for every comparator that was not satisfied in every clause.
+//
+// If v satisfies the Constraint, Explain returns nil.
+//
+//	c, _ := ParseConstraint(">=2.0.0 || <1.5.0")
+//	for _, failure := range c.Explain(Version{Major: 1, Minor: 9}) {
+//		fmt.Println(failure) // "fails >=2.0.0 in clause 1", then "fails <1.5.0 in clause 2"
+//	}
+func (c Constraint) Explain(v Version) []Failure {
+	if len(c.clauses) == 0 {
+		return []Failure{{Reason: "an empty constraint is satisfied by no version"}}
+	}
+
+	var failures []Failure
+	for i, cl := range c.clauses {
+		before := len(failures)
+
+		for _, comp := range cl {
+			if !comp.check(v) {
+				failures = append(failures, Failure{
+					Operator: comp.op.String(),
+					Bound:    comp.version,
+					Reason:   fmt.Sprintf("%s is not %s %s", v, comp.op.phrase(), comp.version),
+					Clause:   i + 1,
+				})
+			}
+		}
+
+		if len(failures) > before {
+			continue
+		}
+
+		if v.Prerelease != "" && !cl.allowsPrerelease(v) {
+			failures = append(failures, Failure{
+				Reason: fmt.Sprintf("pre-release %s is excluded as no comparator names a pre-release of %d.%d.%d", v, v.Major, v.Minor, v.Patch),
+				Clause: i + 1,
+			})
+			continue
+		}
+
+		// The clause was satisfied
+		return nil
+	}
+
+	return failures
+}
+
+// Failure describes a single reason a [Version] did not satisfy a [Constraint].
+type Failure struct {
+	// Operator is the operator of the comparator that was not satisfied e.g. "<".
+	//
+	// It is empty if the failure is due to the pre-release rules rather than any
+	// single comparator, see [Constraint] for details.
+	Operator string
+
+	// Reason is a human readable description of the failure e.g. "1.9.0 is not less than 1.5.0".
+	Reason string
+
+	// Bound is the version the comparator compares against e.g. 1.5.0 in "<1.5.0".
+	//
+	// It is the zero Version if Operator is empty.
+	Bound Version
+
+	// Clause is the 1-based index of the "||" separated clause in which the failure occurred.
+	//
+	// It is 0 if the failure is not due to any one clause, as for the zero value Constraint.
+	Clause int
+}
+
+// String implements the Stringer interface for a Failure.
+//
+//	f := Failure{Operator: "<", Bound: Version{Major: 1, Minor: 5}, Clause: 2}
+//	fmt.Println(f) // "fails <1.5.0 in clause 2"
+func (f Failure) String() string {
+	if f.Clause == 0 {
+		return f.Reason
+	}
+	if f.Operator == "" {
+		return f.Reason + " in clause " + strconv.Itoa(f.Clause)
+	}
+	return "fails " + f.Operator + f.Bound.String() + " in clause " + strconv.Itoa(f.Clause)
+}
+
+// ConstraintError is the error returned by [CheckRange] when a [Version] does
+// not satisfy a range expression.
+type ConstraintError struct {
+	Constraint string    // The range expression that was not satisfied
+	Failures   []Failure // Every reason the Version did not satisfy the Constraint
+	Version    Version   // The version that was checked
+}
+
+// Error implements the error interface for a ConstraintError.
+//
+// It renders a human readable explanation of every failure e.g.
+//
+//	1.9.0 does not satisfy ">=2.0.0 || <1.5.0": fails >=2.0.0 in clause 1; fails <1.5.0 in clause 2
+func (e *ConstraintError) Error() string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "%s does not satisfy %q", e.Version, e.Constraint)
+
+	for i, failure := range e.Failures {
+		if i == 0 {
+			s.WriteString(": ")
+		} else {
+			s.WriteString("; ")
+		}
+		s.WriteString(failure.String())
+	}
+
+	return s.String()
+}
+
+// CheckRange checks v against the range expression expr.
+//
+// If expr is not a valid range expression, the error from [ParseConstraint] is returned. If v
+// does not satisfy it, the error is a [*ConstraintError] explaining which comparators
+// failed and why. If v satisfies expr, CheckRange returns nil.
+//
+//	err := CheckRange(v, ">=2.0.0 || <1.5.0")
+//	var constraintErr *ConstraintError
+//	if errors.As(err, &constraintErr) {
+//		// Inspect constraintErr.Failures
+//	}
+func CheckRange(v Version, expr string) error {
+	c, err := ParseConstraint(expr)
+	if err != nil {
+		return err
+	}
+
+	failures := c.Explain(v)
+	if failures == nil {
+		return nil
+	}
+
+	return &ConstraintError{
+		Constraint: c.String(),
+		Failures:   failures,
+		Version:    v,
+	}
+}
+
+// operator is the comparison operation of a single comparator.
+type operator int
+
+const (
+	opEqual        operator = iota // =
+	opLess                         // <
+	opLessEqual                    // <=
+	opGreater                      // >
+	opGreaterEqual                 // >=
+)
+
+// String implements the Stringer interface for an operator.
+func (o operator) String() string {
+	switch o {
+	case opEqual:
+		return "="
+	case opLess:
+		return "<"
+	case opLessEqual:
+		return "<="
+	case opGreater:
+		return ">"
+	case opGreaterEqual:
+		return ">="
+	default:
+		return "operator(" + strconv.Itoa(int(o)) + ")"
+	}
+}
+
+// phrase returns the operator written out in English, for use in error messages.
+func (o operator) phrase() string {
+	switch o {
+	case opEqual:
+		return "equal to"
+	case opLess:
+		return "less than"
+	case opLessEqual:
+		return "less than or equal to"
+	case opGreater:
+		return "greater than"
+	case opGreaterEqual:
+		return "greater than or equal to"
+	default:
+		return o.String()
+	}
+}
+
+// comparator is the primitive all range syntax is desugared to, a single
+// operator and the version it compares against e.g. ">=1.2.3".
+type comparator struct {
+	version Version
+	op      operator
+}
+
+// String implements the Stringer interface for a comparator.
+func (c comparator) String() string {
+	return c.op.String() + c.version.String()
+}
+
+// check reports whether v satisfies the comparator.
+func (c comparator) check(v Version) bool {
+	cmp := Compare(v, c.version)
+	switch c.op {
+	case opEqual:
+		return cmp == 0
+	case opLess:
+		return cmp < 0
+	case opLessEqual:
+		return cmp <= 0
+	case opGreater:
+		return cmp > 0
+	case opGreaterEqual:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// clause is a set of comparators that must all be satisfied.
+type clause []comparator
+
+// check reports whether v satisfies every comparator in the clause, taking
+// into account the pre-release rules documented on [Constraint].
+func (cl clause) check(v Version) bool {
+	for _, c := range cl {
+		if !c.check(v) {
+			return false
+		}
+	}
+	return v.Prerelease == "" || cl.allowsPrerelease(v)
+}
+
+// allowsPrerelease reports whether any comparator in the clause opts in to
+// pre-releases on the same major.minor.patch tuple as v.
+func (cl clause) allowsPrerelease(v Version) bool {
+	for _, c := range cl {
+		if c.version.Prerelease != "" && sameTuple(c.version, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameTuple reports whether a and b share the same major, minor and patch.
+func sameTuple(a, b Version) bool {
+	return a.Major == b.Major && a.Minor == b.Minor && a.Patch == b.Patch
+}
+
+// anyVersion returns the comparators satisfied by every non pre-release version.
+func anyVersion() []comparator {
+	return []comparator{{op: opGreaterEqual}}
+}
+
+// parseClause parses a single "||" separated clause into its comparators.
+func parseClause(text string) (clause, error) {
+	fields := strings.Fields(text)
+
+	// Hyphen ranges are only valid as an entire clause
+	if len(fields) == 3 && fields[1] == "-" {
+		return parseHyphen(fields[0], fields[2])
+	}
+
+	// Allow whitespace between an operator and its version e.g. ">= 1.2.3"
+	terms := make([]string, 0, len(fields))
+	for i := 0; i < len(fields); i++ {
+		field := fields[i]
+		if isOperator(field) && i+1 < len(fields) {
+			i++
+			field += fields[i]
+		}
+		terms = append(terms, field)
+	}
+
+	if len(terms) == 0 {
+		return anyVersion(), nil
+	}
+
+	var cl clause
+	for _, term := range terms {
+		comparators, err := parseTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		cl = append(cl, comparators...)
+	}
+
+	return cl, nil
+}
+
+// operators recognised at the start of a term, longest first so that
+// ">=" is not mistaken for ">".
+var operators = [...]string{">=", "<=", ">", "<", "=", "~", "^"}
+
+// isOperator reports whether s is a lone operator with no version attached.
+func isOperator(s string) bool {
+	return slices.Contains(operators[:], s)
+}
+
+// parseTerm parses a single whitespace delimited term e.g. "^1.2" and desugars
+// it into the equivalent comparators.
+func parseTerm(term string) ([]comparator, error) {
+	var prefix string
+	for _, op := range operators {
+		if strings.HasPrefix(term, op) {
+			prefix = op
+			break
+		}
+	}
+
+	p, err := parsePartial(strings.TrimPrefix(term, prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	switch prefix {
+	case "~":
+		return tilde(p), nil
+	case "^":
+		return caret(p), nil
+	case ">=":
+		return xrange(opGreaterEqual, p), nil
+	case "<=":
+		return xrange(opLessEqual, p), nil
+	case ">":
+		return xrange(opGreater, p), nil
+	case "<":
+		return xrange(opLess, p), nil
+	default:
+		return xrange(opEqual, p), nil
+	}
+}
+
+// parseHyphen parses and desugars an inclusive hyphen range "lower - upper".
+func parseHyphen(lower, upper string) (clause, error) {
+	lo, err := parsePartial(lower)
+	if err != nil {
+		return nil, err
+	}
+	hi, err := parsePartial(upper)
+	if err != nil {
+		return nil, err
+	}
+
+	var cl clause
+	if lo.given > 0 {
+		cl = append(cl, comparator{op: opGreaterEqual, version: lo.version})
+	}
+
+	switch hi.given {
+	case 0:
+		// No upper bound
+	case 3:
+		cl = append(cl, comparator{op: opLessEqual, version: hi.version})
+	default:
+		cl = append(cl, comparator{op: opLess, version: hi.next()})
+	}
+
+	if len(cl) == 0 {
+		return anyVersion(), nil
+	}
+
+	return cl, nil
+}
+
+// partial is a possibly incomplete version as written in a range expression
+// e.g. "1.2", "1.x" or "*".
+type partial struct {
+	version Version // The version, with any missing components set to 0
+	given   int     // How many of major, minor, patch were actually given
+}
+
+// next returns the lowest version above everything the partial matches, as
+// an exclusive upper bound e.g. "1.2" -> "1.3.0-0".
+//
+// It must not be called on a complete or empty partial.
+func (p partial) next() Version {
+	if p.given == 1 {
+		return Version{Major: p.version.Major + 1, Prerelease: "0"}
+	}
+	return Version{Major: p.version.Major, Minor: p.version.Minor + 1, Prerelease: "0"}
+}
+
+// parsePartial parses a possibly incomplete version, as found in a range expression.
+func parsePartial(text string) (partial, error) {
+	if text == "" {
+		return partial{}, errors.New("missing version")
+	}
+
+	if v, err := Parse(text); err == nil {
+		return partial{version: v, given: 3}, nil
+	}
+
+	parts := strings.Split(strings.TrimPrefix(text, "v"), ".")
+	if len(parts) > 3 {
+		return partial{}, fmt.Errorf("%q is not a valid version", text)
+	}
+
+	var (
+		p        partial
+		wildcard bool
+	)
+	for i, part := range parts {
+		if isWildcard(part) {
+			wildcard = true
+			continue
+		}
+
+		if wildcard || !isNumeric(part) || (len(part) > 1 && part[0] == '0') {
+			// A number after a wildcard e.g. "1.x.3", or just junk
+			return partial{}, fmt.Errorf("%q is not a valid version", text)
+		}
+
+		n, err := strconv.ParseUint(part, 10, 0)
+		if err != nil {
+			return partial{}, fmt.Errorf("%q is not a valid version: %w", text, err)
+		}
+
+		switch i {
+		case 0:
+			p.version.Major = uint(n)
+		case 1:
+			p.version.Minor = uint(n)
+		default:
+			p.version.Patch = uint(n)
+		}
+		p.given++
+	}
+
+	return p, nil
+}
+
+// isWildcard reports whether s is an x-range wildcard.
+func isWildcard(s string) bool {
+	return s == "x" || s == "X" || s == "*"
+}
+
+// xrange desugars op applied to a possibly partial version.
+func xrange(op operator, p partial) []comparator {
+	if p.given == 3 {
+		return []comparator{{op: op, version: p.version}}
+	}
+
+	if p.given == 0 {
+		if op == opLess || op == opGreater {
+			// Nothing is less than or greater than everything
+			return []comparator{{op: opLess, version: Version{Prerelease: "0"}}}
+		}
+		return anyVersion()
+	}
+
+	switch op {
+	case opGreater:
+		// The "-0" from next is only there to exclude pre-releases from upper bounds,
+		// here it would opt in to the pre-releases of the next version
+		lower := p.next()
+		lower.Prerelease = ""
+		return []comparator{{op: opGreaterEqual, version: lower}}
+	case opGreaterEqual:
+		return []comparator{{op: opGreaterEqual, version: p.version}}
+	case opLess:
+		lower := p.version
+		lower.Prerelease = "0"
+		return []comparator{{op: opLess, version: lower}}
+	case opLessEqual:
+		return []comparator{{op: opLess, version: p.next()}}
+	default:
+		return []comparator{
+			{op: opGreaterEqual, version: p.version},
+			{op: opLess, version: p.next()},
+		}
+	}
+}
+
+// tilde desugars a tilde range, allowing patch level changes if a minor
+// version is given and minor level changes if not.
+func tilde(p partial) []comparator {
+	switch p.given {
+	case 0:
+		return anyVersion()
+	case 1:
+		return xrange(opEqual, p)
+	default:
+		return []comparator{
+			{op: opGreaterEqual, version: p.version},
+			{op: opLess, version: partial{version: p.version, given: 2}.next()},
+		}
+	}
+}
+
+// caret desugars a caret range, allowing changes that do not modify the
+// left-most non-zero component.
+func caret(p partial) []comparator {
+	if p.given == 0 {
+		return anyVersion()
+	}
+
+	var upper Version
+	switch {
+	case p.version.Major != 0 || p.given == 1:
+		upper = Version{Major: p.version.Major + 1}
+	case p.version.Minor != 0 || p.given == 2:
+		upper = Version{Minor: p.version.Minor + 1}
+	default:
+		upper = Version{Patch: p.version.Patch + 1}
+	}
+	upper.Prerelease = "0"
+
+	return []comparator{
+		{op: opGreaterEqual, version: p.version},
+		{op: opLess, version: upper},
+	}
+}
@@ -0,0 +1,369 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestConstraintCheck(t *testing.T) {
+	tests := []struct {
+		expr    string
+		version string
+		want    bool
+	}{
+		// Primitive comparators
+		{expr: "1.2.3", version: "1.2.3", want: true},
+		{expr: "1.2.3", version: "1.2.4", want: false},
+		{expr: "=1.2.3", version: "1.2.3", want: true},
+		{expr: "v1.2.3", version: "1.2.3", want: true},
+		{expr: "1.2.3", version: "1.2.3+build.1", want: true},
+		{expr: ">1.2.3", version: "1.2.4", want: true},
+		{expr: ">1.2.3", version: "1.2.3", want: false},
+		{expr: ">=1.2.3", version: "1.2.3", want: true},
+		{expr: ">=1.2.3", version: "1.2.2", want: false},
+		{expr: "<1.2.3", version: "1.2.2", want: true},
+		{expr: "<1.2.3", version: "1.2.3", want: false},
+		{expr: "<=1.2.3", version: "1.2.3", want: true},
+		{expr: "<=1.2.3", version: "1.2.4", want: false},
+		{expr: ">= 1.2.3", version: "1.2.3", want: true},
+
+		// Intersections and unions
+		{expr: ">=1.2.0 <2.0.0", version: "1.9.9", want: true},
+		{expr: ">=1.2.0 <2.0.0", version: "2.0.0", want: false},
+		{expr: ">=1.2.0 <2.0.0", version: "1.1.0", want: false},
+		{expr: ">=1.2.0 <2.0.0 || ^3.1", version: "3.4.0", want: true},
+		{expr: ">=1.2.0 <2.0.0 || ^3.1", version: "3.0.0", want: false},
+		{expr: ">=1.2.0 <2.0.0 || ^3.1", version: "1.5.0", want: true},
+		{expr: "<1.0.0||>=2.0.0", version: "1.5.0", want: false},
+		{expr: "<1.0.0||>=2.0.0", version: "0.5.0", want: true},
+
+		// X-ranges
+		{expr: "", version: "1.2.3", want: true},
+		{expr: "*", version: "0.0.0", want: true},
+		{expr: "x", version: "99.0.0", want: true},
+		{expr: "1.x", version: "1.0.0", want: true},
+		{expr: "1.x", version: "1.99.99", want: true},
+		{expr: "1.x", version: "2.0.0", want: false},
+		{expr: "1.X", version: "0.9.0", want: false},
+		{expr: "1", version: "1.4.2", want: true},
+		{expr: "1.2.*", version: "1.2.7", want: true},
+		{expr: "1.2", version: "1.3.0", want: false},
+		{expr: "1.x.x", version: "1.3.0", want: true},
+		{expr: ">1.2", version: "1.2.9", want: false},
+		{expr: ">1.2", version: "1.3.0", want: true},
+		{expr: ">1.2", version: "1.3.0-alpha", want: false},
+		{expr: ">1", version: "2.0.0-rc", want: false},
+		{expr: ">1", version: "2.0.0", want: true},
+		{expr: ">=1.2", version: "1.2.0", want: true},
+		{expr: "<1.2", version: "1.1.9", want: true},
+		{expr: "<1.2", version: "1.2.0", want: false},
+		{expr: "<=1.2", version: "1.2.9", want: true},
+		{expr: "<=1.2", version: "1.3.0", want: false},
+		{expr: "<*", version: "0.0.0", want: false},
+		{expr: ">x", version: "1.0.0", want: false},
+
+		// Tilde
+		{expr: "~1.4", version: "1.4.0", want: true},
+		{expr: "~1.4", version: "1.4.9", want: true},
+		{expr: "~1.4", version: "1.5.0", want: false},
+		{expr: "~1.2.3", version: "1.2.2", want: false},
+		{expr: "~1.2.3", version: "1.2.9", want: true},
+		{expr: "~1", version: "1.9.0", want: true},
+		{expr: "~1", version: "2.0.0", want: false},
+		{expr: "~ 1.2.3", version: "1.2.5", want: true},
+
+		// Caret
+		{expr: "^1.2.3", version: "1.9.0", want: true},
+		{expr: "^1.2.3", version: "1.2.2", want: false},
+		{expr: "^1.2.3", version: "2.0.0", want: false},
+		{expr: "^0.2.3", version: "0.2.9", want: true},
+		{expr: "^0.2.3", version: "0.3.0", want: false},
+		{expr: "^0.0.3", version: "0.0.3", want: true},
+		{expr: "^0.0.3", version: "0.0.4", want: false},
+		{expr: "^0.0", version: "0.0.9", want: true},
+		{expr: "^0.0", version: "0.1.0", want: false},
+		{expr: "^0.x", version: "0.9.0", want: true},
+		{expr: "^0", version: "1.0.0", want: false},
+		{expr: "^1.2.x", version: "1.3.0", want: true},
+		{expr: "^0.0.x", version: "0.0.9", want: true},
+
+		// Hyphen
+		{expr: "1.2.3 - 1.5.0", version: "1.2.3", want: true},
+		{expr: "1.2.3 - 1.5.0", version: "1.5.0", want: true},
+		{expr: "1.2.3 - 1.5.0", version: "1.5.1", want: false},
+		{expr: "1.2.3 - 1.5.0", version: "1.2.2", want: false},
+		{expr: "1.2 - 1.5", version: "1.5.9", want: true},
+		{expr: "1.2 - 1.5", version: "1.6.0", want: false},
+		{expr: "1.2 - 1.5", version: "1.1.9", want: false},
+		{expr: "* - 2", version: "0.0.1", want: true},
+
+		// Pre-releases
+		{expr: ">=1.2.3-alpha.1", version: "1.2.3-beta", want: true},
+		{expr: ">=1.2.3-alpha.1", version: "1.2.3", want: true},
+		{expr: ">=1.2.3-alpha.1", version: "1.2.4-beta", want: false},
+		{expr: ">=1.2.3-alpha.1", version: "1.2.3-alpha.0", want: false},
+		{expr: ">=1.2.3", version: "1.2.4-beta", want: false},
+		{expr: "*", version: "1.0.0-rc.1", want: false},
+		{expr: "1.x", version: "1.5.0-rc.1", want: false},
+		{expr: "^1.2.3", version: "2.0.0-rc.1", want: false},
+		{expr: "<2.0.0", version: "2.0.0-rc.1", want: false},
+		{expr: "^1.2.3-rc.1", version: "1.2.3-rc.2", want: true},
+		{expr: "^1.2.3-rc.1", version: "1.2.4-rc.2", want: false},
+		{expr: "~1.2.3-beta.2", version: "1.2.3-beta.4", want: true},
+		{expr: "1.2.3-rc.1 || >=2.0.0-beta", version: "2.0.0-rc.1", want: true},
+		{expr: "1.2.3-rc.1 || >=2.0.0-beta", version: "1.2.3-rc.1", want: true},
+		{expr: "1.2.3-rc.1 || >=2.0.0-beta", version: "1.2.3-rc.2", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr+" "+tt.version, func(t *testing.T) {
+			c, err := semver.ParseConstraint(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) returned an error: %v", tt.expr, err)
+			}
+
+			v, err := semver.Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.version, err)
+			}
+
+			if got := c.Check(v); got != tt.want {
+				t.Errorf("%q.Check(%s): got %v, wanted %v", tt.expr, v, got, tt.want)
+			}
+
+			if got := v.Satisfies(c); got != tt.want {
+				t.Errorf("%s.Satisfies(%q): got %v, wanted %v", v, tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseConstraintInvalid(t *testing.T) {
+	tests := []string{
+		"1.2.3.4",
+		"01.2",
+		"1.x.3",
+		">=",
+		"> = 1.2.3",
+		"1.2-rc",
+		"abc",
+		">=1.2.3 <",
+		"1.2.3 - ",
+		"- 1.2.3",
+		"1.2.3 - 2.0.0 - 3.0.0",
+		"~>1.2",
+		"1.2.3 ||| 2.0.0",
+		"=>1.2.3",
+		"1.2.3-01",
+		"99999999999999999999.0",
+	}
+
+	for _, expr := range tests {
+		t.Run(expr, func(t *testing.T) {
+			_, err := semver.ParseConstraint(expr)
+			if err == nil {
+				t.Fatalf("ParseConstraint(%q) did not return an error", expr)
+			}
+		})
+	}
+}
+
+func TestConstraintString(t *testing.T) {
+	c, err := semver.ParseConstraint("  >=1.2.0 <2.0.0 || ^3.1 ")
+	if err != nil {
+		t.Fatalf("ParseConstraint returned an error: %v", err)
+	}
+
+	want := ">=1.2.0 <2.0.0 || ^3.1"
+	if got := c.String(); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestConstraintZeroValue(t *testing.T) {
+	var c semver.Constraint
+	if c.Check(semver.Version{Major: 1}) {
+		t.Error("Zero value Constraint should be satisfied by nothing")
+	}
+
+	failures := c.Explain(semver.Version{Major: 1})
+	if len(failures) != 1 {
+		t.Fatalf("Explain on a zero value Constraint returned %d failures, wanted 1: %v", len(failures), failures)
+	}
+
+	want := "an empty constraint is satisfied by no version"
+	if got := failures[0].String(); got != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func BenchmarkConstraintCheck(b *testing.B) {
+	c, err := semver.ParseConstraint(">=1.2.0 <2.0.0 || ^3.1")
+	if err != nil {
+		b.Fatalf("ParseConstraint returned an error: %v", err)
+	}
+
+	v := semver.Version{Major: 3, Minor: 4, Patch: 1}
+
+	for b.Loop() {
+		_ = c.Check(v)
+	}
+}
+
+func ExampleParseConstraint() {
+	c, err := semver.ParseConstraint(">=1.2.0 <2.0.0 || ^3.1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, str := range []string{"1.4.0", "2.5.0", "3.1.7", "3.2.0-rc.1"} {
+		v, err := semver.Parse(str)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Printf("%s: %v\n", v, c.Check(v))
+	}
+	// Output:
+	// 1.4.0: true
+	// 2.5.0: false
+	// 3.1.7: true
+	// 3.2.0-rc.1: false
+}
+
+func TestConstraintExplain(t *testing.T) {
+	tests := []struct {
+		expr    string
+		version string
+		want    []semver.Failure
+	}{
+		{
+			expr:    ">=1.2.0 <2.0.0",
+			version: "1.5.0",
+			want:    nil,
+		},
+		{
+			expr:    ">=1.2.0 <2.0.0 || >=3.0.0",
+			version: "3.1.0",
+			want:    nil,
+		},
+		{
+			expr:    ">=2.0.0 || <1.5.0",
+			version: "1.9.0",
+			want: []semver.Failure{
+				{
+					Operator: ">=",
+					Bound:    semver.Version{Major: 2},
+					Reason:   "1.9.0 is not greater than or equal to 2.0.0",
+					Clause:   1,
+				},
+				{
+					Operator: "<",
+					Bound:    semver.Version{Major: 1, Minor: 5},
+					Reason:   "1.9.0 is not less than 1.5.0",
+					Clause:   2,
+				},
+			},
+		},
+		{
+			expr:    "^1.2",
+			version: "2.0.0",
+			want: []semver.Failure{
+				{
+					Operator: "<",
+					Bound:    semver.Version{Major: 2, Prerelease: "0"},
+					Reason:   "2.0.0 is not less than 2.0.0-0",
+					Clause:   1,
+				},
+			},
+		},
+		{
+			expr:    ">=1.0.0",
+			version: "1.2.0-rc.1",
+			want: []semver.Failure{
+				{
+					Reason: "pre-release 1.2.0-rc.1 is excluded as no comparator names a pre-release of 1.2.0",
+					Clause: 1,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr+" "+tt.version, func(t *testing.T) {
+			c, err := semver.ParseConstraint(tt.expr)
+			if err != nil {
+				t.Fatalf("ParseConstraint(%q) returned an error: %v", tt.expr, err)
+			}
+
+			v, err := semver.Parse(tt.version)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.version, err)
+			}
+
+			got := c.Explain(v)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, tt.want)
+			}
+
+			// Explain must agree with Check
+			if (got == nil) != c.Check(v) {
+				t.Errorf("Explain returned %v but Check returned %v", got, c.Check(v))
+			}
+		})
+	}
+}
+
+func TestCheckRange(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 9}
+
+	if err := semver.CheckRange(v, "^1.2"); err != nil {
+		t.Fatalf("CheckRange returned an unexpected error: %v", err)
+	}
+
+	err := semver.CheckRange(v, "1.2.3.4")
+	if err == nil {
+		t.Fatal("CheckRange did not return an error for an invalid expression")
+	}
+	var constraintErr *semver.ConstraintError
+	if errors.As(err, &constraintErr) {
+		t.Fatalf("CheckRange returned a *ConstraintError for an invalid expression: %v", err)
+	}
+
+	err = semver.CheckRange(v, ">=2.0.0 || <1.5.0")
+	if !errors.As(err, &constraintErr) {
+		t.Fatalf("CheckRange did not return a *ConstraintError: %v", err)
+	}
+
+	if len(constraintErr.Failures) != 2 {
+		t.Errorf("wrong number of failures: got %d, wanted %d", len(constraintErr.Failures), 2)
+	}
+
+	want := `1.9.0 does not satisfy ">=2.0.0 || <1.5.0": fails >=2.0.0 in clause 1; fails <1.5.0 in clause 2`
+	if got := err.Error(); got != want {
+		t.Errorf("\nGot:\t%s\nWanted:\t%s\n", got, want)
+	}
+}
+
+func ExampleCheckRange() {
+	v := semver.Version{Major: 1, Minor: 9}
+
+	err := semver.CheckRange(v, ">=2.0.0 || <1.5.0")
+	fmt.Println(err)
+
+	var constraintErr *semver.ConstraintError
+	if errors.As(err, &constraintErr) {
+		for _, failure := range constraintErr.Failures {
+			fmt.Println(failure.Reason)
+		}
+	}
+	// Output:
+	// 1.9.0 does not satisfy ">=2.0.0 || <1.5.0": fails >=2.0.0 in clause 1; fails <1.5.0 in clause 2
+	// 1.9.0 is not greater than or equal to 2.0.0
+	// 1.9.0 is not less than 1.5.0
+}
@@ -0,0 +1,126 @@
+package semver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// MarshalText implements [encoding.TextMarshaler] for a Version, encoding
+// it as its canonical string form e.g. "1.2.3-rc.1+build.5".
+//
+// This means a Version is encoded as a plain string by encoding/json,
+// encoding/xml and any other package that respects [encoding.TextMarshaler],
+// rather than as an object of its fields.
+func (v Version) MarshalText() ([]byte, error) {
+	return v.appendTo(make([]byte, 0, v.size())), nil
+}
+
+// AppendText implements [encoding.TextAppender] for a Version, appending
+// its canonical string form to b.
+func (v Version) AppendText(b []byte) ([]byte, error) {
+	return v.appendTo(b), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] for a Version, parsing
+// text with [Parse].
+//
+// If text is not a valid semantic version, the error from [Parse] is returned
+// and v is left unchanged.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// binaryFormatV1 is the leading format byte of the current binary encoding.
+const binaryFormatV1 byte = 1
+
+// MarshalBinary implements [encoding.BinaryMarshaler] for a Version, producing
+// a compact binary encoding that is generally much smaller than the string form.
+//
+// The encoding begins with a format byte (currently 1) so that it may evolve, then
+// the major, minor and patch versions as unsigned varints (see [binary.AppendUvarint]),
+// then the pre-release and build metadata each as a varint length followed by the
+// bytes of the string.
+func (v Version) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, v.size()))
+}
+
+// AppendBinary implements [encoding.BinaryAppender] for a Version, appending
+// the encoding described in [Version.MarshalBinary] to b.
+func (v Version) AppendBinary(b []byte) ([]byte, error) {
+	b = append(b, binaryFormatV1)
+	b = binary.AppendUvarint(b, uint64(v.Major))
+	b = binary.AppendUvarint(b, uint64(v.Minor))
+	b = binary.AppendUvarint(b, uint64(v.Patch))
+	b = binary.AppendUvarint(b, uint64(len(v.Prerelease)))
+	b = append(b, v.Prerelease...)
+	b = binary.AppendUvarint(b, uint64(len(v.Build)))
+	b = append(b, v.Build...)
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler] for a Version, decoding
+// the encoding described in [Version.MarshalBinary].
+//
+// Malformed input, including an unknown format, trailing data or a pre-release or build
+// metadata that is not valid per the spec, results in an error and v is left unchanged.
+func (v *Version) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("invalid binary version: empty input")
+	}
+
+	if format := data[0]; format != binaryFormatV1 {
+		return fmt.Errorf("invalid binary version: unknown format %d", format)
+	}
+	data = data[1:]
+
+	var nums [3]uint
+	names := [...]string{major, minor, patch}
+	for i := range nums {
+		n, size := binary.Uvarint(data)
+		if size <= 0 {
+			return fmt.Errorf("invalid binary version: malformed %s version", names[i])
+		}
+		if n > math.MaxUint {
+			return fmt.Errorf("invalid binary version: %s version: %w", names[i], ErrOverflow)
+		}
+		nums[i] = uint(n)
+		data = data[size:]
+	}
+
+	var strs [2]string
+	for i, name := range [...]string{pre, build} {
+		length, size := binary.Uvarint(data)
+		if size <= 0 || length > uint64(len(data)-size) {
+			return fmt.Errorf("invalid binary version: malformed %s", name)
+		}
+		data = data[size:]
+
+		str := string(data[:length])
+		if str != "" && !validIdentifiers(str, name == pre) {
+			return fmt.Errorf("invalid binary version: invalid %s %q", name, str)
+		}
+		strs[i] = str
+		data = data[length:]
+	}
+
+	if len(data) != 0 {
+		return fmt.Errorf("invalid binary version: %d trailing bytes", len(data))
+	}
+
+	*v = Version{
+		Prerelease: strs[0],
+		Build:      strs[1],
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
+	}
+
+	return nil
+}
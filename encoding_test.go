@@ -0,0 +1,319 @@
+package semver_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var (
+	_ encoding.TextMarshaler     = semver.Version{}
+	_ encoding.TextAppender      = semver.Version{}
+	_ encoding.TextUnmarshaler   = (*semver.Version)(nil)
+	_ encoding.BinaryMarshaler   = semver.Version{}
+	_ encoding.BinaryAppender    = semver.Version{}
+	_ encoding.BinaryUnmarshaler = (*semver.Version)(nil)
+)
+
+func TestMarshalText(t *testing.T) {
+	for str, version := range valid {
+		t.Run(str, func(t *testing.T) {
+			text, err := version.MarshalText()
+			if err != nil {
+				t.Fatalf("MarshalText returned an error: %v", err)
+			}
+
+			if got := string(text); got != version.String() {
+				t.Errorf("got %q, wanted %q", got, version.String())
+			}
+
+			var got semver.Version
+			if err := got.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText returned an error: %v", err)
+			}
+
+			if got != version {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, version)
+			}
+		})
+	}
+}
+
+func TestUnmarshalTextInvalid(t *testing.T) {
+	for _, str := range invalid {
+		t.Run(str, func(t *testing.T) {
+			original := semver.Version{Major: 1, Minor: 2, Patch: 3}
+			v := original
+
+			err := v.UnmarshalText([]byte(str))
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Fatalf("UnmarshalText(%q) did not return ErrInvalidVersion, got %v", str, err)
+			}
+
+			if v != original {
+				t.Errorf("UnmarshalText modified the version on error: %#v", v)
+			}
+		})
+	}
+}
+
+func TestAppendText(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+
+	got, err := v.AppendText([]byte("version: "))
+	if err != nil {
+		t.Fatalf("AppendText returned an error: %v", err)
+	}
+
+	want := "version: 1.2.3-rc.1+build.5"
+	if string(got) != want {
+		t.Errorf("got %q, wanted %q", got, want)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	type manifest struct {
+		Name    string         `json:"name"`
+		Version semver.Version `json:"version"`
+	}
+
+	original := manifest{
+		Name:    "semver",
+		Version: semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"},
+	}
+
+	got, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal returned an error: %v", err)
+	}
+
+	want := `{"name":"semver","version":"1.2.3-rc.1+build.5"}`
+	if string(got) != want {
+		t.Errorf("\nGot:\t%s\nWanted:\t%s\n", got, want)
+	}
+
+	var decoded manifest
+	if err := json.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal returned an error: %v", err)
+	}
+
+	if decoded != original {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", decoded, original)
+	}
+
+	err = json.Unmarshal([]byte(`{"name":"semver","version":"1.02.3"}`), &decoded)
+	if !errors.Is(err, semver.ErrInvalidVersion) {
+		t.Errorf("json.Unmarshal of an invalid version did not return ErrInvalidVersion, got %v", err)
+	}
+}
+
+func TestXML(t *testing.T) {
+	type release struct {
+		XMLName  xml.Name       `xml:"release"`
+		Previous semver.Version `xml:"previous,attr"`
+		Version  semver.Version `xml:"version"`
+	}
+
+	original := release{
+		XMLName:  xml.Name{Local: "release"},
+		Previous: semver.Version{Major: 1, Minor: 2, Patch: 2},
+		Version:  semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"},
+	}
+
+	got, err := xml.Marshal(original)
+	if err != nil {
+		t.Fatalf("xml.Marshal returned an error: %v", err)
+	}
+
+	want := `<release previous="1.2.2"><version>1.2.3-rc.1</version></release>`
+	if string(got) != want {
+		t.Errorf("\nGot:\t%s\nWanted:\t%s\n", got, want)
+	}
+
+	var decoded release
+	if err := xml.Unmarshal(got, &decoded); err != nil {
+		t.Fatalf("xml.Unmarshal returned an error: %v", err)
+	}
+
+	if decoded != original {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", decoded, original)
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	for str, version := range valid {
+		t.Run(str, func(t *testing.T) {
+			data, err := version.MarshalBinary()
+			if err != nil {
+				t.Fatalf("MarshalBinary returned an error: %v", err)
+			}
+
+			if data[0] != 1 {
+				t.Errorf("wrong format byte: got %d, wanted %d", data[0], 1)
+			}
+
+			var got semver.Version
+			if err := got.UnmarshalBinary(data); err != nil {
+				t.Fatalf("UnmarshalBinary returned an error: %v", err)
+			}
+
+			if got != version {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, version)
+			}
+		})
+	}
+}
+
+func TestMarshalBinaryLayout(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 300, Prerelease: "rc.1", Build: "b5"}
+
+	got, err := v.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary returned an error: %v", err)
+	}
+
+	want := []byte{
+		0x01,       // Format
+		0x01,       // Major
+		0x02,       // Minor
+		0xac, 0x02, // Patch (300 as a uvarint)
+		0x04,               // len(Prerelease)
+		'r', 'c', '.', '1', // Prerelease
+		0x02,     // len(Build)
+		'b', '5', // Build
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("\nGot:\t%x\nWanted:\t%x\n", got, want)
+	}
+
+	if len(got) >= len(v.String()) {
+		t.Errorf("binary encoding (%d bytes) is not smaller than the string (%d bytes)", len(got), len(v.String()))
+	}
+
+	appended, err := v.AppendBinary([]byte("prefix"))
+	if err != nil {
+		t.Fatalf("AppendBinary returned an error: %v", err)
+	}
+
+	if !bytes.Equal(appended, append([]byte("prefix"), want...)) {
+		t.Errorf("AppendBinary did not append, got %x", appended)
+	}
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "empty", data: nil},
+		{name: "unknown format", data: []byte{0x02, 0x01, 0x02, 0x03, 0x00, 0x00}},
+		{name: "truncated major", data: []byte{0x01, 0x80}},
+		{name: "missing minor", data: []byte{0x01, 0x01}},
+		{name: "missing patch", data: []byte{0x01, 0x01, 0x02}},
+		{name: "missing prerelease", data: []byte{0x01, 0x01, 0x02, 0x03}},
+		{name: "missing build", data: []byte{0x01, 0x01, 0x02, 0x03, 0x00}},
+		{name: "prerelease too long", data: []byte{0x01, 0x01, 0x02, 0x03, 0x10, 'r', 'c', 0x00}},
+		{name: "huge length", data: []byte{0x01, 0x01, 0x02, 0x03, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01}},
+		{name: "invalid prerelease", data: []byte{0x01, 0x01, 0x02, 0x03, 0x02, '0', '1', 0x00}},
+		{name: "empty prerelease identifier", data: []byte{0x01, 0x01, 0x02, 0x03, 0x03, 'r', 'c', '.', 0x00}},
+		{name: "invalid build", data: []byte{0x01, 0x01, 0x02, 0x03, 0x00, 0x02, 'b', '_'}},
+		{name: "trailing bytes", data: []byte{0x01, 0x01, 0x02, 0x03, 0x00, 0x00, 0x00}},
+		{
+			name: "overflow",
+			data: []byte{0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x00, 0x00, 0x00, 0x00},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := semver.Version{Major: 1, Minor: 2, Patch: 3}
+			v := original
+
+			if err := v.UnmarshalBinary(tt.data); err == nil {
+				t.Fatalf("UnmarshalBinary(%x) did not return an error, got %#v", tt.data, v)
+			}
+
+			if v != original {
+				t.Errorf("UnmarshalBinary modified the version on error: %#v", v)
+			}
+		})
+	}
+}
+
+// FuzzUnmarshalBinary fuzzes UnmarshalBinary with random input, ensuring it never panics
+// and that anything it accepts is a valid version that round trips.
+func FuzzUnmarshalBinary(f *testing.F) {
+	for _, version := range valid {
+		data, err := version.MarshalBinary()
+		if err != nil {
+			f.Fatalf("MarshalBinary returned an error: %v", err)
+		}
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v semver.Version
+		if err := v.UnmarshalBinary(data); err != nil {
+			return
+		}
+
+		if _, err := semver.Parse(v.String()); err != nil {
+			t.Fatalf("UnmarshalBinary(%x) accepted an invalid version %#v: %v", data, v, err)
+		}
+
+		encoded, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary returned an error: %v", err)
+		}
+
+		var decoded semver.Version
+		if err := decoded.UnmarshalBinary(encoded); err != nil {
+			t.Fatalf("UnmarshalBinary(%x) returned an error: %v", encoded, err)
+		}
+
+		if decoded != v {
+			t.Fatalf("\nDecoded:\t%#v\nOriginal:\t%#v\n", decoded, v)
+		}
+	})
+}
+
+func BenchmarkAppendText(b *testing.B) {
+	v := semver.Version{
+		Prerelease: "rc1",
+		Build:      "build.123",
+		Major:      3,
+		Minor:      4,
+		Patch:      12,
+	}
+
+	buf := make([]byte, 0, 64)
+
+	for b.Loop() {
+		var err error
+		buf, err = v.AppendText(buf[:0])
+		if err != nil {
+			b.Fatalf("AppendText returned an error: %v", err)
+		}
+	}
+}
+
+func ExampleVersion_MarshalText() {
+	type manifest struct {
+		Version semver.Version `json:"version"`
+	}
+
+	data, err := json.Marshal(manifest{Version: semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(string(data))
+	// Output: {"version":"1.2.3-rc.1"}
+}
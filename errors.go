@@ -0,0 +1,69 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidVersion is returned (wrapped in a [ParseError]) when text is not
+	// a valid semantic version.
+	ErrInvalidVersion = errors.New("invalid semantic version")
+
+	// ErrOverflow is returned (wrapped in a [ParseError]) from [Parse] when a version is
+	// syntactically valid but one of its major, minor or patch components is too large
+	// to fit in a uint.
+	//
+	// The semver spec places no limit on the size of these, so ecosystems that need
+	// versions this large should use [ParseBig] instead.
+	ErrOverflow = errors.New("value overflows uint")
+)
+
+// ParseError is the error returned when parsing a semantic version fails.
+//
+// It records exactly where in the input, and why, parsing failed so that
+// callers may render helpful diagnostics.
+//
+// A ParseError wraps either [ErrInvalidVersion] or [ErrOverflow], so callers
+// may use [errors.Is] to distinguish the two.
+type ParseError struct {
+	// Err is the underlying sentinel error, either [ErrInvalidVersion] or [ErrOverflow].
+	Err error
+
+	// Input is the text that failed to parse.
+	Input string
+
+	// Component is the part of the version in which the failure occurred, one of
+	// "major", "minor", "patch", "prerelease" or "build".
+	Component string
+
+	// Reason describes the failure e.g. "leading zero in numeric identifier".
+	Reason string
+
+	// Offset is the byte offset into Input of the first offending character. It
+	// may be equal to len(Input) if the input ended unexpectedly.
+	Offset int
+}
+
+// Error implements the error interface for a ParseError.
+//
+//	parse "1.02.3": leading zero in numeric identifier in minor at offset 2
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse %q: %s in %s at offset %d", e.Input, e.Reason, e.Component, e.Offset)
+}
+
+// Unwrap returns the underlying sentinel error, allowing use of [errors.Is].
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// newParseError builds a ParseError from a failed scan of text.
+func newParseError(text string, err scanError) *ParseError {
+	return &ParseError{
+		Err:       ErrInvalidVersion,
+		Input:     text,
+		Component: err.component,
+		Reason:    err.reason,
+		Offset:    err.offset,
+	}
+}
@@ -0,0 +1,120 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		sentinel  error
+		input     string
+		component string
+		reason    string
+		offset    int
+	}{
+		{input: "", component: "major", reason: "unexpected end of input", offset: 0, sentinel: semver.ErrInvalidVersion},
+		{input: "v", component: "major", reason: "unexpected end of input", offset: 1, sentinel: semver.ErrInvalidVersion},
+		{input: "alpha", component: "major", reason: "expected a digit", offset: 0, sentinel: semver.ErrInvalidVersion},
+		{input: "-1.1.2", component: "major", reason: "expected a digit", offset: 0, sentinel: semver.ErrInvalidVersion},
+		{input: "01.1.1", component: "major", reason: "leading zero in numeric identifier", offset: 0, sentinel: semver.ErrInvalidVersion},
+		{input: "v1.02.3", component: "minor", reason: "leading zero in numeric identifier", offset: 3, sentinel: semver.ErrInvalidVersion},
+		{input: "1", component: "minor", reason: "unexpected end of input", offset: 1, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2", component: "patch", reason: "unexpected end of input", offset: 3, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2-SNAPSHOT", component: "patch", reason: "expected '.'", offset: 3, sentinel: semver.ErrInvalidVersion},
+		{input: "1.1.-2", component: "patch", reason: "expected a digit", offset: 4, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2.3.DEV", component: "patch", reason: "unexpected character", offset: 5, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2.3-", component: "prerelease", reason: "empty identifier", offset: 6, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2.3-0123", component: "prerelease", reason: "leading zero in numeric identifier", offset: 6, sentinel: semver.ErrInvalidVersion},
+		{input: "1.2.3-rc.01", component: "prerelease", reason: "leading zero in numeric identifier", offset: 9, sentinel: semver.ErrInvalidVersion},
+		{input: "1.0.0-alpha..1", component: "prerelease", reason: "empty identifier", offset: 12, sentinel: semver.ErrInvalidVersion},
+		{input: "1.0.0-alpha_beta", component: "prerelease", reason: "invalid character", offset: 11, sentinel: semver.ErrInvalidVersion},
+		{input: "1.1.2+.123", component: "build", reason: "empty identifier", offset: 6, sentinel: semver.ErrInvalidVersion},
+		{input: "1.1.2+meta.", component: "build", reason: "empty identifier", offset: 11, sentinel: semver.ErrInvalidVersion},
+		{input: "9.8.7+meta+meta", component: "build", reason: "invalid character", offset: 10, sentinel: semver.ErrInvalidVersion},
+		{input: "99999999999999999999.0.0", component: "major", reason: "value overflows uint", offset: 0, sentinel: semver.ErrOverflow},
+		{input: "v1.99999999999999999999.0", component: "minor", reason: "value overflows uint", offset: 3, sentinel: semver.ErrOverflow},
+		{input: "1.2.99999999999999999999-rc.1", component: "patch", reason: "value overflows uint", offset: 4, sentinel: semver.ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := semver.Parse(tt.input)
+
+			var parseErr *semver.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) did not return a *ParseError, got %v", tt.input, err)
+			}
+
+			if !errors.Is(err, tt.sentinel) {
+				t.Errorf("Parse(%q) error does not wrap %v", tt.input, tt.sentinel)
+			}
+
+			if parseErr.Input != tt.input {
+				t.Errorf("wrong Input: got %q, wanted %q", parseErr.Input, tt.input)
+			}
+
+			if parseErr.Component != tt.component {
+				t.Errorf("wrong Component: got %q, wanted %q", parseErr.Component, tt.component)
+			}
+
+			if parseErr.Reason != tt.reason {
+				t.Errorf("wrong Reason: got %q, wanted %q", parseErr.Reason, tt.reason)
+			}
+
+			if parseErr.Offset != tt.offset {
+				t.Errorf("wrong Offset: got %d, wanted %d", parseErr.Offset, tt.offset)
+			}
+		})
+	}
+}
+
+func TestParseErrorInvalid(t *testing.T) {
+	for _, str := range invalid {
+		t.Run(str, func(t *testing.T) {
+			_, err := semver.Parse(str)
+
+			var parseErr *semver.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Parse(%q) did not return a *ParseError, got %v", str, err)
+			}
+
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Errorf("Parse(%q) error does not wrap ErrInvalidVersion", str)
+			}
+
+			if parseErr.Offset < 0 || parseErr.Offset > len(str) {
+				t.Errorf("Offset %d is outside of input %q", parseErr.Offset, str)
+			}
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	_, err := semver.Parse("1.02.3")
+	if err == nil {
+		t.Fatal("Parse did not return an error")
+	}
+
+	want := `parse "1.02.3": leading zero in numeric identifier in minor at offset 2`
+	if got := err.Error(); got != want {
+		t.Errorf("\nGot:\t%s\nWanted:\t%s\n", got, want)
+	}
+}
+
+func ExampleParseError() {
+	_, err := semver.Parse("1.2.3-rc.01")
+
+	var parseErr *semver.ParseError
+	if errors.As(err, &parseErr) {
+		fmt.Println(parseErr.Input)
+		fmt.Println(strings.Repeat(" ", parseErr.Offset) + "^ " + parseErr.Reason)
+	}
+	// Output:
+	// 1.2.3-rc.01
+	//          ^ leading zero in numeric identifier
+}
@@ -0,0 +1,125 @@
+package semver
+
+import "strings"
+
+// Match is a semantic version found in some larger text by [FindAll].
+type Match struct {
+	Version  Version // The version that was found
+	Start    int     // Byte offset of the start of the match in the text, including any leading 'v'
+	End      int     // Byte offset just past the end of the match, so that it is text[Start:End]
+	Prefixed bool    // Whether the match had a leading 'v'
+}
+
+// FindAll returns every semantic version in text, in order, along with where each was found.
+//
+// Versions are only matched as whole words, so the version must not be immediately preceded
+// by a letter, digit, '_' or '.', and must not be immediately followed by anything else that
+// could be part of a version. This means that neither "1.2.3.4" nor "abc1.2.3def" contain a
+// version, while "release-1.2.3", "(v1.2.3)" and "Released 1.2.3." all do.
+//
+// As with [Parse], a leading 'v' is allowed and is included in the match. Versions too large to
+// be represented by a [Version] (see [ErrOverflow]) are not matched.
+//
+// If text contains no versions, FindAll returns nil.
+//
+//	matches := FindAll("FROM golang:1.26.0-alpine AS build")
+//	matches[0].Version // 1.26.0-alpine
+func FindAll(text string) []Match {
+	var matches []Match
+
+	for i := 0; i < len(text); {
+		if !startsVersion(text, i) {
+			i++
+			continue
+		}
+
+		// Take the whole run of characters that could be part of a version
+		// then require that the run is one, so we never match part of something
+		// that merely looks like a version e.g. "1.2.3.4"
+		end := i
+		for end < len(text) && isVersionChar(text[end]) {
+			end++
+		}
+
+		// Allow a version to end a sentence
+		candidate := strings.TrimRight(text[i:end], ".")
+
+		if end == len(text) || text[end] != '_' {
+			if v, prefixed, err := parse(candidate, false); err == nil {
+				matches = append(matches, Match{
+					Version:  v,
+					Start:    i,
+					End:      i + len(candidate),
+					Prefixed: prefixed,
+				})
+			}
+		}
+
+		i = end
+	}
+
+	return matches
+}
+
+// ReplaceAll returns a copy of text with every version found by [FindAll] replaced by the
+// result of calling fn with it.
+//
+// A version that had a leading 'v' is replaced by the [Version.Tag] of the new version,
+// otherwise by its [Version.String], so the style of each reference is preserved.
+//
+//	ReplaceAll("uses: actions/checkout@v4.1.0", func(v Version) Version {
+//		return BumpMinor(v)
+//	}) // "uses: actions/checkout@v4.2.0"
+func ReplaceAll(text string, fn func(Version) Version) string {
+	matches := FindAll(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+
+	last := 0
+	for _, match := range matches {
+		b.WriteString(text[last:match.Start])
+
+		replacement := fn(match.Version)
+		if match.Prefixed {
+			b.WriteString(replacement.Tag())
+		} else {
+			b.WriteString(replacement.String())
+		}
+
+		last = match.End
+	}
+
+	b.WriteString(text[last:])
+
+	return b.String()
+}
+
+// startsVersion reports whether a version could start at text[i], that is
+// text[i] is a digit, or a 'v' followed by a digit, and is at a word boundary.
+func startsVersion(text string, i int) bool {
+	if i > 0 {
+		if prev := text[i-1]; isAlphanumeric(prev) || prev == '_' || prev == '.' {
+			return false
+		}
+	}
+
+	if text[i] == 'v' {
+		i++
+	}
+
+	return i < len(text) && isDigit(text[i])
+}
+
+// isVersionChar reports whether c may appear anywhere in a version string.
+func isVersionChar(c byte) bool {
+	return isIdentifierChar(c) || c == '.' || c == '+'
+}
+
+// isAlphanumeric reports whether c is an ASCII letter or digit.
+func isAlphanumeric(c byte) bool {
+	return isIdentifierChar(c) && c != '-'
+}
@@ -0,0 +1,172 @@
+package semver_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string // The matched text of each match
+	}{
+		{text: "", want: nil},
+		{text: "no versions here", want: nil},
+		{text: "1.2.3", want: []string{"1.2.3"}},
+		{text: "v1.2.3", want: []string{"v1.2.3"}},
+		{text: "Released 1.2.3.", want: []string{"1.2.3"}},
+		{text: "Released 1.2.3-rc.1.", want: []string{"1.2.3-rc.1"}},
+		{text: "(v1.2.3)", want: []string{"v1.2.3"}},
+		{text: "release-1.2.3", want: []string{"1.2.3"}},
+		{text: "FROM golang:1.26.0-alpine AS build", want: []string{"1.26.0-alpine"}},
+		{text: "mytool version 2.0.0+build.5, commit abc123", want: []string{"2.0.0+build.5"}},
+		{text: "upgrade 1.2.3 -> 1.3.0 (was v0.9.9)", want: []string{"1.2.3", "1.3.0", "v0.9.9"}},
+		{text: `"version": "1.0.0-beta.2"`, want: []string{"1.0.0-beta.2"}},
+		{text: "line one 1.0.0\nline two 2.0.0", want: []string{"1.0.0", "2.0.0"}},
+
+		// Boundaries
+		{text: "1.2.3.4", want: nil},
+		{text: "1.2.3.4.", want: nil},
+		{text: "abc1.2.3def", want: nil},
+		{text: "abc1.2.3", want: nil},
+		{text: "1.2.3def", want: nil},
+		{text: "x_1.2.3", want: nil},
+		{text: "1.2.3_x", want: nil},
+		{text: "dev1.2.3", want: nil},
+		{text: "V1.2.3", want: nil},
+		{text: "1.2", want: nil},
+		{text: "1.02.3", want: nil},
+		{text: "1.2.3-", want: nil},
+		{text: "1.2.3+", want: nil},
+		{text: "1.2.3-rc..1", want: nil},
+		{text: "1.2.3.4 and 5.6.7", want: []string{"5.6.7"}},
+		{text: "99999999999999999999.0.0", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			matches := semver.FindAll(tt.text)
+
+			var got []string
+			for _, match := range matches {
+				text := tt.text[match.Start:match.End]
+				got = append(got, text)
+
+				v, prefixed, err := semver.ParseWithPrefix(text)
+				if err != nil {
+					t.Fatalf("matched text %q is not a valid version: %v", text, err)
+				}
+
+				if v != match.Version || prefixed != match.Prefixed {
+					t.Errorf("match %#v does not agree with its text %q", match, text)
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("\nGot:\t%q\nWanted:\t%q\n", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceAll(t *testing.T) {
+	tests := []struct {
+		fn   func(semver.Version) semver.Version
+		text string
+		want string
+	}{
+		{
+			text: "no versions here",
+			fn:   semver.BumpMinor,
+			want: "no versions here",
+		},
+		{
+			text: "uses: actions/checkout@v4.1.0",
+			fn:   semver.BumpMinor,
+			want: "uses: actions/checkout@v4.2.0",
+		},
+		{
+			text: "FROM golang:1.26.0-alpine AS build",
+			fn:   semver.BumpPatch,
+			want: "FROM golang:1.26.0 AS build",
+		},
+		{
+			text: "upgrade 1.2.3 -> 1.3.0 (was v0.9.9), not 1.2.3.4.",
+			fn:   semver.BumpMajor,
+			want: "upgrade 2.0.0 -> 2.0.0 (was v1.0.0), not 1.2.3.4.",
+		},
+		{
+			text: "Released 1.2.3.",
+			fn:   func(v semver.Version) semver.Version { return v },
+			want: "Released 1.2.3.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			if got := semver.ReplaceAll(tt.text, tt.fn); got != tt.want {
+				t.Errorf("\nGot:\t%q\nWanted:\t%q\n", got, tt.want)
+			}
+		})
+	}
+}
+
+// FuzzFindAll ensures FindAll never panics, and that every match is in bounds,
+// in order, non-overlapping and exactly a valid version.
+func FuzzFindAll(f *testing.F) {
+	for str := range valid {
+		f.Add("version " + str + ".")
+	}
+	f.Add("1.2.3.4 abc1.2.3def v1.2.3")
+
+	f.Fuzz(func(t *testing.T, text string) {
+		last := 0
+		for _, match := range semver.FindAll(text) {
+			if match.Start < last || match.End <= match.Start || match.End > len(text) {
+				t.Fatalf("match %#v out of bounds or order for %q", match, text)
+			}
+
+			v, prefixed, err := semver.ParseWithPrefix(text[match.Start:match.End])
+			if err != nil {
+				t.Fatalf("match %q in %q is not a valid version: %v", text[match.Start:match.End], text, err)
+			}
+
+			if v != match.Version || prefixed != match.Prefixed {
+				t.Fatalf("match %#v does not agree with its text %q", match, text[match.Start:match.End])
+			}
+
+			last = match.End
+		}
+
+		identity := func(v semver.Version) semver.Version { return v }
+		if got := semver.ReplaceAll(text, identity); got != text {
+			t.Fatalf("ReplaceAll with identity changed %q to %q", text, got)
+		}
+	})
+}
+
+func ExampleFindAll() {
+	text := "mytool version 2.1.0-rc.1+build.5 (requires go1.26, libfoo v1.4.2)"
+
+	for _, match := range semver.FindAll(text) {
+		fmt.Printf("%s at [%d:%d]\n", match.Version, match.Start, match.End)
+	}
+
+	// Output:
+	// 2.1.0-rc.1+build.5 at [15:33]
+	// 1.4.2 at [59:65]
+}
+
+func ExampleReplaceAll() {
+	changelog := "## v1.4.0\n\nRequires libfoo 2.3.1 or later."
+
+	fmt.Println(semver.ReplaceAll(changelog, semver.BumpMinor))
+
+	// Output:
+	// ## v1.5.0
+	//
+	// Requires libfoo 2.4.0 or later.
+}
@@ -0,0 +1,70 @@
+package semver
+
+import "flag"
+
+// Set implements [flag.Value] for a *Version, parsing text with [Parse].
+//
+// This means a *Version may be used directly as a command line flag, with an invalid
+// version rejected at flag parsing time. If text is not a valid semantic version,
+// the [ParseError] from [Parse] is returned and v is left unchanged.
+//
+//	var v Version
+//	flag.Var(&v, "target-version", "The version to release")
+func (v *Version) Set(text string) error {
+	return v.UnmarshalText([]byte(text))
+}
+
+// Get implements [flag.Getter] for a *Version, returning the Version itself.
+func (v *Version) Get() any {
+	return *v
+}
+
+// Set implements [flag.Value] for a *Constraint, parsing expr with [ParseConstraint].
+//
+// If expr is not a valid range expression the error from [ParseConstraint] is returned
+// and c is left unchanged.
+//
+//	var c Constraint
+//	flag.Var(&c, "requires", "The range of supported versions")
+func (c *Constraint) Set(expr string) error {
+	parsed, err := ParseConstraint(expr)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// Get implements [flag.Getter] for a *Constraint, returning the Constraint itself.
+func (c *Constraint) Get() any {
+	return *c
+}
+
+// VersionVar defines a [Version] flag on fs with the specified name, default value
+// and usage string, in the style of [flag.StringVar]. The argument p points to a
+// Version variable in which to store the value of the flag.
+//
+// If fs is nil, the flag is defined on [flag.CommandLine].
+//
+//	var minVersion Version
+//	VersionVar(fs, &minVersion, "min-version", Version{Major: 1}, "The minimum supported version")
+func VersionVar(fs *flag.FlagSet, p *Version, name string, value Version, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	*p = value
+	fs.Var(p, name, usage)
+}
+
+// ConstraintVar defines a [Constraint] flag on fs with the specified name, default
+// value and usage string, in the style of [flag.StringVar]. The argument p points to a
+// Constraint variable in which to store the value of the flag.
+//
+// If fs is nil, the flag is defined on [flag.CommandLine].
+func ConstraintVar(fs *flag.FlagSet, p *Constraint, name string, value Constraint, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	*p = value
+	fs.Var(p, name, usage)
+}
@@ -0,0 +1,157 @@
+package semver_test
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var (
+	_ flag.Getter = (*semver.Version)(nil)
+	_ flag.Getter = (*semver.Constraint)(nil)
+)
+
+func TestVersionVar(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want semver.Version
+	}{
+		{
+			name: "default",
+			args: []string{},
+			want: semver.Version{Major: 1},
+		},
+		{
+			name: "set",
+			args: []string{"--min-version", "v2.3.4-rc.1"},
+			want: semver.Version{Major: 2, Minor: 3, Patch: 4, Prerelease: "rc.1"},
+		},
+		{
+			name: "equals",
+			args: []string{"--min-version=0.1.0+build.5"},
+			want: semver.Version{Minor: 1, Build: "build.5"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+
+			var got semver.Version
+			semver.VersionVar(fs, &got, "min-version", semver.Version{Major: 1}, "The minimum version")
+
+			if err := fs.Parse(tt.args); err != nil {
+				t.Fatalf("Parse returned an error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, tt.want)
+			}
+
+			if getter := fs.Lookup("min-version").Value.(flag.Getter); getter.Get() != tt.want {
+				t.Errorf("Get returned %#v, wanted %#v", getter.Get(), tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionVarInvalid(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	original := semver.Version{Major: 1}
+
+	var got semver.Version
+	semver.VersionVar(fs, &got, "target-version", original, "The version to release")
+
+	err := fs.Parse([]string{"--target-version", "1.02.3"})
+	if err == nil {
+		t.Fatal("Parse did not return an error for an invalid version")
+	}
+
+	if got != original {
+		t.Errorf("an invalid flag modified the version: %#v", got)
+	}
+
+	// The flag package doesn't wrap, so check Set directly for the structured error
+	var parseErr *semver.ParseError
+	if err := got.Set("1.02.3"); !errors.As(err, &parseErr) {
+		t.Fatalf("Set did not return a *ParseError, got %T: %v", err, err)
+	}
+
+	if parseErr.Component != "minor" {
+		t.Errorf("wrong component: got %q, wanted %q", parseErr.Component, "minor")
+	}
+}
+
+func TestConstraintVar(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	def, err := semver.ParseConstraint("*")
+	if err != nil {
+		t.Fatalf("ParseConstraint returned an error: %v", err)
+	}
+
+	var got semver.Constraint
+	semver.ConstraintVar(fs, &got, "requires", def, "The supported range")
+
+	if got.String() != "*" {
+		t.Errorf("default not applied, got %q", got)
+	}
+
+	if err := fs.Parse([]string{"--requires", ">=1.2.0 <2.0.0"}); err != nil {
+		t.Fatalf("Parse returned an error: %v", err)
+	}
+
+	if got.String() != ">=1.2.0 <2.0.0" {
+		t.Errorf("got %q, wanted %q", got, ">=1.2.0 <2.0.0")
+	}
+
+	if !got.Check(semver.Version{Major: 1, Minor: 5}) {
+		t.Error("parsed flag constraint not satisfied by 1.5.0")
+	}
+
+	if err := fs.Parse([]string{"--requires", ">=1.x.2 <"}); err == nil {
+		t.Error("Parse did not return an error for an invalid constraint")
+	}
+
+	if got.String() != ">=1.2.0 <2.0.0" {
+		t.Errorf("an invalid flag modified the constraint: %q", got)
+	}
+}
+
+func TestVersionVarNilFlagSet(t *testing.T) {
+	var got semver.Version
+	semver.VersionVar(nil, &got, "semver-test-version", semver.Version{Major: 3}, "A test flag")
+
+	f := flag.CommandLine.Lookup("semver-test-version")
+	if f == nil {
+		t.Fatal("flag not defined on flag.CommandLine")
+	}
+
+	if f.DefValue != "3.0.0" {
+		t.Errorf("wrong default: got %q, wanted %q", f.DefValue, "3.0.0")
+	}
+}
+
+func ExampleVersionVar() {
+	fs := flag.NewFlagSet("release", flag.ContinueOnError)
+
+	var target semver.Version
+	semver.VersionVar(fs, &target, "target-version", semver.Version{}, "The version to release")
+
+	if err := fs.Parse([]string{"--target-version", "v1.4.0-rc.1"}); err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(target)
+	// Output: 1.4.0-rc.1
+}
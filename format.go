@@ -0,0 +1,119 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format implements [fmt.Formatter] for a Version, supporting the following verbs:
+//
+//   - %s and %v: the canonical form as returned by [Version.String], including any
+//     pre-release and build metadata
+//   - %+v: the same as %v, the build metadata is always included in the canonical form
+//   - %#v: Go syntax for the Version e.g. semver.Version{Major: 1, Minor: 2, Patch: 3, ...}
+//   - %q: the canonical form as a double quoted Go string
+//
+// Width, precision and the '-' flag are honoured as they are for strings, so for
+// example "%-12s" left aligns a version in a column. Any other verb is reported
+// as a bad verb, as [fmt] does for other types.
+//
+// To render only some of the components, e.g. "1.2" or "v1", see [Version.Render].
+func (v Version) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(
+				f,
+				"semver.Version{Major: %d, Minor: %d, Patch: %d, Prerelease: %q, Build: %q}",
+				v.Major, v.Minor, v.Patch, v.Prerelease, v.Build,
+			)
+			return
+		}
+		// The '+' flag means nothing to %s so is harmless to pass along
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), v.String())
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), v.String())
+	default:
+		fmt.Fprintf(f, "%%!%c(semver.Version=%s)", verb, v.String())
+	}
+}
+
+// Render renders v according to layout, replacing each placeholder with the
+// corresponding component of v and copying everything else verbatim.
+//
+// The placeholders are:
+//
+//   - {major}, {minor} and {patch}: the numeric components
+//   - {prerelease}: the pre-release without a leading '-', empty if there is none
+//   - {build}: the build metadata without a leading '+', empty if there is none
+//   - {-prerelease}: the pre-release with a leading '-', or nothing if there is none
+//   - {+build}: the build metadata with a leading '+', or nothing if there is none
+//
+// Anything in braces that is not a placeholder is copied as is.
+//
+//	v := Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+//	v.Render("{major}.{minor}")                      // "1.2"
+//	v.Render("release-{major}.{minor}.x")            // "release-1.2.x"
+//	v.Render("{major}.{minor}.{patch}{-prerelease}") // "1.2.3-rc.1"
+//	v.Render("v{major}")                             // "v1"
+func (v Version) Render(layout string) string {
+	b := make([]byte, 0, len(layout)+v.size())
+
+	for layout != "" {
+		open := strings.IndexByte(layout, '{')
+		if open == -1 {
+			break
+		}
+
+		b = append(b, layout[:open]...)
+		layout = layout[open:]
+
+		closing := strings.IndexByte(layout, '}')
+		if closing == -1 {
+			break
+		}
+
+		if !v.appendPlaceholder(&b, layout[1:closing]) {
+			// Not a placeholder, copy the '{' and carry on after it
+			// as there may be a placeholder inside e.g. "{{major}}"
+			b = append(b, '{')
+			layout = layout[1:]
+			continue
+		}
+
+		layout = layout[closing+1:]
+	}
+
+	return string(append(b, layout...))
+}
+
+// appendPlaceholder appends the value of the named [Version.Render] placeholder
+// to b, reporting whether name was a placeholder at all.
+func (v Version) appendPlaceholder(b *[]byte, name string) bool {
+	switch name {
+	case major:
+		*b = strconv.AppendUint(*b, uint64(v.Major), 10)
+	case minor:
+		*b = strconv.AppendUint(*b, uint64(v.Minor), 10)
+	case patch:
+		*b = strconv.AppendUint(*b, uint64(v.Patch), 10)
+	case pre:
+		*b = append(*b, v.Prerelease...)
+	case build:
+		*b = append(*b, v.Build...)
+	case "-" + pre:
+		if v.Prerelease != "" {
+			*b = append(*b, '-')
+			*b = append(*b, v.Prerelease...)
+		}
+	case "+" + build:
+		if v.Build != "" {
+			*b = append(*b, '+')
+			*b = append(*b, v.Build...)
+		}
+	default:
+		return false
+	}
+	return true
+}
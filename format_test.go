@@ -0,0 +1,113 @@
+package semver_test
+
+import (
+	"fmt"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var _ fmt.Formatter = semver.Version{}
+
+func TestFormat(t *testing.T) {
+	full := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	plain := semver.Version{Major: 1, Minor: 2, Patch: 3}
+
+	tests := []struct {
+		format  string
+		want    string
+		version semver.Version
+	}{
+		{format: "%v", version: full, want: "1.2.3-rc.1+build.5"},
+		{format: "%s", version: full, want: "1.2.3-rc.1+build.5"},
+		{format: "%+v", version: full, want: "1.2.3-rc.1+build.5"},
+		{format: "%+v", version: plain, want: "1.2.3"},
+		{format: "%v", version: plain, want: "1.2.3"},
+		{format: "%q", version: full, want: `"1.2.3-rc.1+build.5"`},
+		{format: "%10s|", version: plain, want: "     1.2.3|"},
+		{format: "%-10v|", version: plain, want: "1.2.3     |"},
+		{format: "%.3s", version: plain, want: "1.2"},
+		{
+			format:  "%#v",
+			version: full,
+			want:    `semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}`,
+		},
+		{
+			format:  "%#v",
+			version: semver.Version{},
+			want:    `semver.Version{Major: 0, Minor: 0, Patch: 0, Prerelease: "", Build: ""}`,
+		},
+		{format: "%d", version: plain, want: "%!d(semver.Version=1.2.3)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.version); got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	full := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	plain := semver.Version{Major: 1, Minor: 2, Patch: 3}
+
+	tests := []struct {
+		layout  string
+		want    string
+		version semver.Version
+	}{
+		{layout: "", version: full, want: ""},
+		{layout: "no placeholders", version: full, want: "no placeholders"},
+		{layout: "{major}.{minor}", version: full, want: "1.2"},
+		{layout: "release-{major}.{minor}.x", version: full, want: "release-1.2.x"},
+		{layout: "{major}.{minor}.{patch}{-prerelease}", version: full, want: "1.2.3-rc.1"},
+		{layout: "{major}.{minor}.{patch}{-prerelease}", version: plain, want: "1.2.3"},
+		{layout: "{major}.{minor}.{patch}{-prerelease}{+build}", version: full, want: full.String()},
+		{layout: "{major}.{minor}.{patch}{-prerelease}{+build}", version: plain, want: plain.String()},
+		{layout: "v{major}", version: full, want: "v1"},
+		{layout: "{prerelease}/{build}", version: full, want: "rc.1/build.5"},
+		{layout: "{prerelease}/{build}", version: plain, want: "/"},
+		{layout: "{unknown}.{major}", version: full, want: "{unknown}.1"},
+		{layout: "{{major}}", version: full, want: "{1}"},
+		{layout: "{major", version: full, want: "{major"},
+		{layout: "}{major}{", version: full, want: "}1{"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.layout, func(t *testing.T) {
+			if got := tt.version.Render(tt.layout); got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleVersion_Format() {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+
+	fmt.Printf("%v\n", v)
+	fmt.Printf("%q\n", v)
+	fmt.Printf("%#v\n", v)
+
+	// Output:
+	// 1.2.3-rc.1+build.5
+	// "1.2.3-rc.1+build.5"
+	// semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+}
+
+func ExampleVersion_Render() {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+
+	fmt.Println(v.Render("{major}.{minor}"))
+	fmt.Println(v.Render("release-{major}.{minor}.x"))
+	fmt.Println(v.Render("{major}.{minor}.{patch}{-prerelease}"))
+	fmt.Println(v.Render("v{major}"))
+
+	// Output:
+	// 1.2
+	// release-1.2.x
+	// 1.2.3-rc.1
+	// v1
+}
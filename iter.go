@@ -0,0 +1,79 @@
+package semver
+
+import (
+	"iter"
+	"slices"
+)
+
+// ParseAll returns an iterator that parses each string from seq with [Parse],
+// yielding the resulting Version and error.
+//
+// Parsing is lazy, each string is only parsed when the iterator asks for it, and
+// an error does not stop iteration. Use [OnlyValid] to skip strings that are not
+// valid versions, e.g. the non-version tags in a git repository.
+//
+//	for v, err := range ParseAll(slices.Values(tags)) {
+//		...
+//	}
+func ParseAll(seq iter.Seq[string]) iter.Seq2[Version, error] {
+	return func(yield func(Version, error) bool) {
+		for text := range seq {
+			if !yield(Parse(text)) {
+				return
+			}
+		}
+	}
+}
+
+// OnlyValid returns an iterator over the versions from seq that have no error,
+// discarding those that do.
+//
+//	for v := range OnlyValid(ParseAll(slices.Values(tags))) {
+//		...
+//	}
+func OnlyValid(seq iter.Seq2[Version, error]) iter.Seq[Version] {
+	return func(yield func(Version) bool) {
+		for v, err := range seq {
+			if err != nil {
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over the versions from seq for which keep returns true.
+//
+// It is the lazy equivalent of [Versions.Filter].
+//
+//	c, _ := ParseConstraint("1.4.x")
+//	for v := range Filter(versions, c.Check) {
+//		...
+//	}
+func Filter(seq iter.Seq[Version], keep func(Version) bool) iter.Seq[Version] {
+	return func(yield func(Version) bool) {
+		for v := range seq {
+			if keep(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Sorted returns an iterator over the versions from seq in ascending order of
+// precedence, as in [Versions.Sort].
+//
+// Sorting needs every version, so seq is consumed in full when iteration starts.
+func Sorted(seq iter.Seq[Version]) iter.Seq[Version] {
+	return func(yield func(Version) bool) {
+		sorted := Versions(slices.Collect(seq))
+		sorted.Sort()
+		for _, v := range sorted {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestParseAll(t *testing.T) {
+	texts := []string{"1.2.3", "not-a-version", "v2.0.0-rc.1", "1.02.3"}
+
+	var (
+		got  []semver.Version
+		errs []error
+	)
+	for v, err := range semver.ParseAll(slices.Values(texts)) {
+		got = append(got, v)
+		errs = append(errs, err)
+	}
+
+	want := []semver.Version{{Major: 1, Minor: 2, Patch: 3}, {}, {Major: 2, Prerelease: "rc.1"}, {}}
+	if !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+
+	for i, err := range errs {
+		wantErr := i == 1 || i == 3
+		if (err != nil) != wantErr {
+			t.Errorf("ParseAll(%q): got error %v, wanted error: %v", texts[i], err, wantErr)
+		}
+		if wantErr && !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("ParseAll(%q) did not return ErrInvalidVersion, got %v", texts[i], err)
+		}
+	}
+}
+
+func TestParseAllLazy(t *testing.T) {
+	var pulled int
+	seq := func(yield func(string) bool) {
+		for _, text := range []string{"1.0.0", "2.0.0", "3.0.0"} {
+			pulled++
+			if !yield(text) {
+				return
+			}
+		}
+	}
+
+	for v, err := range semver.ParseAll(seq) {
+		if err != nil {
+			t.Fatalf("ParseAll returned an error: %v", err)
+		}
+		if v.Major == 1 {
+			break
+		}
+	}
+
+	if pulled != 1 {
+		t.Errorf("ParseAll pulled %d strings, wanted 1", pulled)
+	}
+}
+
+func TestOnlyValid(t *testing.T) {
+	texts := []string{"v1.2.3", "latest", "1.3.0", "release-candidate", "0.1.0-alpha"}
+
+	got := slices.Collect(semver.OnlyValid(semver.ParseAll(slices.Values(texts))))
+	want := []semver.Version{{Major: 1, Minor: 2, Patch: 3}, {Major: 1, Minor: 3}, {Minor: 1, Prerelease: "alpha"}}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	vs := mustParseAll(t, "1.3.9", "1.4.0", "1.4.2-rc.1", "1.4.2", "1.5.0")
+
+	c, err := semver.ParseConstraint("1.4.x")
+	if err != nil {
+		t.Fatalf("ParseConstraint returned an error: %v", err)
+	}
+
+	got := slices.Collect(semver.Filter(slices.Values(vs), c.Check))
+	if !slices.Equal(got, vs.Filter(c.Check)) {
+		t.Errorf("Filter and Versions.Filter disagree: %v != %v", got, vs.Filter(c.Check))
+	}
+
+	// Stopping early
+	for v := range semver.Filter(slices.Values(vs), c.Check) {
+		if v.Patch != 0 {
+			t.Errorf("iteration did not stop after the first version, got %s", v)
+		}
+		break
+	}
+}
+
+func TestSorted(t *testing.T) {
+	vs := mustParseAll(t, "2.0.0", "1.0.0+b", "1.0.0-rc.1", "0.9.0", "1.0.0+a", "1.10.0", "1.2.0")
+
+	want := slices.Clone(vs)
+	want.Sort()
+
+	got := slices.Collect(semver.Sorted(slices.Values(vs)))
+	if !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+
+	// Stopping early
+	var first []semver.Version
+	for v := range semver.Sorted(slices.Values(vs)) {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+
+	if !slices.Equal(first, want[:2]) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", first, want[:2])
+	}
+}
+
+func ExampleParseAll() {
+	// e.g. the output of git for-each-ref --format='%(refname:short)' refs/tags
+	tags := []string{"v1.4.0", "v1.3.2", "nightly", "v2.0.0-rc.1", "v1.4.1", "v1.3.10"}
+
+	versions := semver.OnlyValid(semver.ParseAll(slices.Values(tags)))
+
+	for v := range semver.Sorted(semver.Filter(versions, semver.Version.IsStable)) {
+		fmt.Println(v)
+	}
+
+	// Output:
+	// 1.3.2
+	// 1.3.10
+	// 1.4.0
+	// 1.4.1
+}
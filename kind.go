@@ -0,0 +1,134 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind is a component of a semantic version that may be bumped, or that
+// changed between two versions.
+//
+// Kinds are ordered by significance, so for example Diff(a, b) >= Minor
+// reports whether anything more significant than a patch changed.
+type Kind uint
+
+const (
+	// None means no component, bumping by None leaves a version unchanged and
+	// Diff reports None for versions of equal precedence.
+	None Kind = iota
+
+	// Prerelease is the pre-release, see [BumpPrerelease].
+	Prerelease
+
+	// Patch is the patch version, see [BumpPatch].
+	Patch
+
+	// Minor is the minor version, see [BumpMinor].
+	Minor
+
+	// Major is the major version, see [BumpMajor].
+	Major
+)
+
+// kindNames maps each Kind to its name, indexed by Kind.
+var kindNames = [...]string{
+	None:       "none",
+	Prerelease: pre,
+	Patch:      patch,
+	Minor:      minor,
+	Major:      major,
+}
+
+// ParseKind parses the name of a Kind, as returned by [Kind.String], e.g. "minor".
+//
+// Parsing is case insensitive, so "Minor" and "MINOR" are also accepted, making it
+// suitable for taking the kind of bump as a command line argument.
+//
+//	kind, _ := ParseKind("minor")
+//	Bump(Version{Major: 1, Minor: 2, Patch: 3}, kind) // 1.3.0
+func ParseKind(text string) (Kind, error) {
+	for kind, name := range kindNames {
+		if strings.EqualFold(text, name) {
+			return Kind(kind), nil
+		}
+	}
+
+	return None, fmt.Errorf("invalid kind %q, expected one of %s", text, strings.Join(kindNames[:], ", "))
+}
+
+// String implements the Stringer interface for a Kind.
+//
+//	fmt.Println(Minor) // "minor"
+func (k Kind) String() string {
+	if k < Kind(len(kindNames)) {
+		return kindNames[k]
+	}
+	return fmt.Sprintf("Kind(%d)", uint(k))
+}
+
+// MarshalText implements [encoding.TextMarshaler] for a Kind, encoding it as its name.
+func (k Kind) MarshalText() ([]byte, error) {
+	if k >= Kind(len(kindNames)) {
+		return nil, fmt.Errorf("invalid kind %d", uint(k))
+	}
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler] for a Kind, parsing text
+// with [ParseKind].
+//
+// This means a Kind may be used as a command line flag with [flag.TextVar].
+func (k *Kind) UnmarshalText(text []byte) error {
+	parsed, err := ParseKind(string(text))
+	if err != nil {
+		return err
+	}
+	*k = parsed
+	return nil
+}
+
+// Bump returns a new Version with the component kind bumped, using [BumpMajor],
+// [BumpMinor], [BumpPatch] or [BumpPrerelease] (with no label) as appropriate.
+//
+// If kind is None, or not a known Kind, current is returned unchanged.
+//
+//	Bump(Version{Major: 1, Minor: 2, Patch: 3}, Minor) // 1.3.0
+func Bump(current Version, kind Kind) Version {
+	switch kind {
+	case Major:
+		return BumpMajor(current)
+	case Minor:
+		return BumpMinor(current)
+	case Patch:
+		return BumpPatch(current)
+	case Prerelease:
+		next, _ := BumpPrerelease(current, "") //nolint: errcheck // Only an invalid label can fail, and an empty one is always valid
+		return next
+	default:
+		return current
+	}
+}
+
+// Diff reports the most significant component that differs between a and b,
+// in either direction.
+//
+// Build metadata does not contribute to precedence so is ignored, versions of
+// equal precedence (see [Version.Equal]) give None.
+//
+//	a, _ := Parse("1.2.3")
+//	b, _ := Parse("1.4.0")
+//	Diff(a, b) // Minor
+func Diff(a, b Version) Kind {
+	switch {
+	case a.Major != b.Major:
+		return Major
+	case a.Minor != b.Minor:
+		return Minor
+	case a.Patch != b.Patch:
+		return Patch
+	case comparePrerelease(a.Prerelease, b.Prerelease) != 0:
+		return Prerelease
+	default:
+		return None
+	}
+}
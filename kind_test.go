@@ -0,0 +1,240 @@
+package semver_test
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var (
+	_ encoding.TextMarshaler   = semver.None
+	_ encoding.TextUnmarshaler = (*semver.Kind)(nil)
+)
+
+func TestKindString(t *testing.T) {
+	tests := []struct {
+		want string
+		kind semver.Kind
+	}{
+		{kind: semver.None, want: "none"},
+		{kind: semver.Prerelease, want: "prerelease"},
+		{kind: semver.Patch, want: "patch"},
+		{kind: semver.Minor, want: "minor"},
+		{kind: semver.Major, want: "major"},
+		{kind: semver.Kind(42), want: "Kind(42)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.kind.String(); got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	for _, kind := range []semver.Kind{semver.None, semver.Prerelease, semver.Patch, semver.Minor, semver.Major} {
+		got, err := semver.ParseKind(kind.String())
+		if err != nil {
+			t.Fatalf("ParseKind(%q) returned an error: %v", kind, err)
+		}
+
+		if got != kind {
+			t.Errorf("ParseKind(%q): got %v, wanted %v", kind, got, kind)
+		}
+	}
+
+	if got, err := semver.ParseKind("MINOR"); err != nil || got != semver.Minor {
+		t.Errorf("ParseKind is not case insensitive, got (%v, %v)", got, err)
+	}
+
+	for _, text := range []string{"", "majr", "Kind(42)", " major"} {
+		if _, err := semver.ParseKind(text); err == nil {
+			t.Errorf("ParseKind(%q) did not return an error", text)
+		}
+	}
+}
+
+func TestKindText(t *testing.T) {
+	text, err := semver.Major.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText returned an error: %v", err)
+	}
+
+	if string(text) != "major" {
+		t.Errorf("got %q, wanted %q", text, "major")
+	}
+
+	if _, err := semver.Kind(42).MarshalText(); err == nil {
+		t.Error("MarshalText of an unknown kind did not return an error")
+	}
+
+	// As a command line flag
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+
+	var kind semver.Kind
+	fs.TextVar(&kind, "bump", semver.Patch, "The kind of bump")
+
+	if err := fs.Parse([]string{"--bump", "minor"}); err != nil {
+		t.Fatalf("Parse returned an error: %v", err)
+	}
+
+	if kind != semver.Minor {
+		t.Errorf("got %v, wanted %v", kind, semver.Minor)
+	}
+
+	if err := fs.Parse([]string{"--bump", "huge"}); err == nil {
+		t.Error("Parse did not return an error for an invalid kind")
+	}
+}
+
+func TestBump(t *testing.T) {
+	release := semver.Version{Major: 1, Minor: 2, Patch: 3, Build: "build.5"}
+	rc := semver.Version{Major: 1, Minor: 3, Prerelease: "rc.1"}
+
+	tests := []struct {
+		name    string
+		current semver.Version
+		want    semver.Version
+		kind    semver.Kind
+	}{
+		{name: "none", current: release, kind: semver.None, want: release},
+		{name: "unknown", current: release, kind: semver.Kind(42), want: release},
+		{name: "major", current: release, kind: semver.Major, want: semver.BumpMajor(release)},
+		{name: "minor", current: release, kind: semver.Minor, want: semver.BumpMinor(release)},
+		{name: "patch", current: release, kind: semver.Patch, want: semver.BumpPatch(release)},
+		{name: "prerelease", current: release, kind: semver.Prerelease, want: semver.Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "0"}},
+		{name: "minor finalises", current: rc, kind: semver.Minor, want: semver.Version{Major: 1, Minor: 3}},
+		{name: "prerelease increments", current: rc, kind: semver.Prerelease, want: semver.Version{Major: 1, Minor: 3, Prerelease: "rc.2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := semver.Bump(tt.current, tt.kind); got != tt.want {
+				t.Errorf("got %#v, wanted %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want semver.Kind
+	}{
+		{a: "1.2.3", b: "1.2.3", want: semver.None},
+		{a: "1.2.3", b: "1.2.3+build.5", want: semver.None},
+		{a: "1.2.3-rc.1+a", b: "1.2.3-rc.1+b", want: semver.None},
+		{a: "1.2.3", b: "1.2.4", want: semver.Patch},
+		{a: "1.2.3", b: "1.4.0", want: semver.Minor},
+		{a: "1.2.3", b: "2.2.3", want: semver.Major},
+		{a: "2.0.0", b: "1.9.9", want: semver.Major},
+		{a: "1.2.3-rc.1", b: "1.2.3-rc.2", want: semver.Prerelease},
+		{a: "1.2.3-rc.1", b: "1.2.3", want: semver.Prerelease},
+		{a: "1.2.3-rc.1", b: "1.2.4-rc.1", want: semver.Patch},
+		{a: "1.2.3-rc.1", b: "1.3.0", want: semver.Minor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, err := semver.Parse(tt.a)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.a, err)
+			}
+
+			b, err := semver.Parse(tt.b)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned an error: %v", tt.b, err)
+			}
+
+			if got := semver.Diff(a, b); got != tt.want {
+				t.Errorf("Diff(%s, %s): got %v, wanted %v", a, b, got, tt.want)
+			}
+
+			if got := semver.Diff(b, a); got != tt.want {
+				t.Errorf("Diff(%s, %s): got %v, wanted %v", b, a, got, tt.want)
+			}
+		})
+	}
+}
+
+// FuzzBumpDiff ensures that bumping a valid version by any kind gives a
+// version of higher precedence, and that for a release it differs by that kind.
+func FuzzBumpDiff(f *testing.F) {
+	for str := range valid {
+		f.Add(str, uint(semver.Minor))
+	}
+
+	f.Fuzz(func(t *testing.T, s string, k uint) {
+		v, err := semver.Parse(s)
+		if err != nil || v.Major == math.MaxUint || v.Minor == math.MaxUint || v.Patch == math.MaxUint {
+			// Bumping these would overflow
+			return
+		}
+
+		kind := semver.Kind(k%uint(semver.Major)) + 1 // Anything but None
+
+		bumped := semver.Bump(v, kind)
+		if !v.Less(bumped) {
+			t.Fatalf("Bump(%s, %v) = %s is not greater", v, kind, bumped)
+		}
+
+		if v.Prerelease != "" {
+			// Finalising or incrementing a pre-release may change anything from
+			// the pre-release up to the bumped component
+			return
+		}
+
+		want := kind
+		if kind == semver.Prerelease {
+			// Starting a pre-release series on a release also bumps the patch
+			want = semver.Patch
+		}
+
+		if diff := semver.Diff(v, bumped); diff != want {
+			t.Fatalf("Diff(%s, %s) = %v, wanted %v", v, bumped, diff, want)
+		}
+	})
+}
+
+func ExampleDiff() {
+	current, err := semver.Parse("1.2.3")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, candidate := range []string{"1.2.4", "1.3.0", "2.0.0"} {
+		next, err := semver.Parse(candidate)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+
+		kind := semver.Diff(current, next)
+		fmt.Printf("%s -> %s is a %s upgrade, auto merge: %v\n", current, next, kind, kind < semver.Minor)
+	}
+
+	// Output:
+	// 1.2.3 -> 1.2.4 is a patch upgrade, auto merge: true
+	// 1.2.3 -> 1.3.0 is a minor upgrade, auto merge: false
+	// 1.2.3 -> 2.0.0 is a major upgrade, auto merge: false
+}
+
+func ExampleBump() {
+	kind, err := semver.ParseKind("minor")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(semver.Bump(semver.Version{Major: 1, Minor: 2, Patch: 3}, kind))
+	// Output: 1.3.0
+}
@@ -0,0 +1,213 @@
+package semver
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Coercion is a set of rules that [ParseLenient] may apply to coerce a not quite
+// valid version string into a valid semantic version.
+//
+// Each rule is a single bit so they may be combined with '|' to choose exactly
+// which coercions are allowed, e.g. CoerceTrimSpace|CoercePadMissing.
+type Coercion uint
+
+const (
+	// CoerceTrimSpace trims leading and trailing whitespace: " 1.2.3 " -> "1.2.3".
+	CoerceTrimSpace Coercion = 1 << iota
+
+	// CoerceUppercaseV accepts an uppercase 'V' prefix as well as 'v': "V1.2.3" -> "1.2.3".
+	CoerceUppercaseV
+
+	// CoerceStripPrefix strips any non-numeric text before the version: "release-1.2.3" -> "1.2.3".
+	//
+	// A version following a non-alphanumeric character is preferred, so "app2-1.2.3" -> "1.2.3",
+	// otherwise the version starts at the first digit: "go1.21.3" -> "1.21.3".
+	CoerceStripPrefix
+
+	// CoercePadMissing fills in a missing minor and/or patch version with 0: "1.2" -> "1.2.0", "v1" -> "1.0.0".
+	CoercePadMissing
+
+	// CoerceTruncateExtra drops any numeric components after the patch version: "1.2.3.4" -> "1.2.3".
+	CoerceTruncateExtra
+
+	// CoerceLeadingZeros removes leading zeros from the major, minor and patch versions: "1.02.3" -> "1.2.3".
+	CoerceLeadingZeros
+
+	// CoerceAll allows every coercion.
+	CoerceAll = CoerceTrimSpace | CoerceUppercaseV | CoerceStripPrefix | CoercePadMissing | CoerceTruncateExtra | CoerceLeadingZeros
+)
+
+// coercionNames maps each individual Coercion to its name.
+var coercionNames = [...]struct {
+	name     string
+	coercion Coercion
+}{
+	{name: "TrimSpace", coercion: CoerceTrimSpace},
+	{name: "UppercaseV", coercion: CoerceUppercaseV},
+	{name: "StripPrefix", coercion: CoerceStripPrefix},
+	{name: "PadMissing", coercion: CoercePadMissing},
+	{name: "TruncateExtra", coercion: CoerceTruncateExtra},
+	{name: "LeadingZeros", coercion: CoerceLeadingZeros},
+}
+
+// String implements the Stringer interface for a Coercion, listing
+// the names of each rule in the set.
+//
+//	fmt.Println(CoerceTrimSpace | CoercePadMissing) // "TrimSpace|PadMissing"
+func (c Coercion) String() string {
+	if c == 0 {
+		return "None"
+	}
+
+	var names []string
+	for _, each := range coercionNames {
+		if c&each.coercion != 0 {
+			names = append(names, each.name)
+			c &^= each.coercion
+		}
+	}
+
+	if c != 0 {
+		// Bits that aren't any known coercion
+		names = append(names, "Coercion(0x"+strconv.FormatUint(uint64(c), 16)+")")
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Has reports whether every rule in other is also in c.
+func (c Coercion) Has(other Coercion) bool {
+	return c&other == other
+}
+
+// ParseLenient parses text into a Version like [Parse], but applies any of the
+// allowed coercions necessary to turn a messy real-world version string into a
+// valid semantic version.
+//
+// It returns the set of coercions that were actually applied, which will be 0
+// if text was already a valid semantic version. If text cannot be made valid using
+// only the allowed coercions, an error is returned.
+//
+// The returned error wraps the [*ParseError] from parsing the coerced form of text,
+// so its Input and Offset refer to that rather than to text itself.
+//
+//	v, applied, _ := ParseLenient(" release-1.2 ", CoerceAll)
+//	fmt.Println(v)       // "1.2.0"
+//	fmt.Println(applied) // "TrimSpace|StripPrefix|PadMissing"
+func ParseLenient(text string, allowed Coercion) (Version, Coercion, error) {
+	if v, err := Parse(text); err == nil {
+		return v, 0, nil
+	}
+
+	var applied Coercion
+	s := text
+
+	if allowed.Has(CoerceTrimSpace) {
+		if trimmed := strings.TrimSpace(s); trimmed != s {
+			s = trimmed
+			applied |= CoerceTrimSpace
+		}
+	}
+
+	if allowed.Has(CoerceStripPrefix) {
+		if start := prefixLen(s); start != -1 {
+			s = s[start:]
+			applied |= CoerceStripPrefix
+		}
+	}
+
+	switch {
+	case hasPrefixV(s, 'v'):
+		// Always allowed, not a coercion
+		s = s[1:]
+	case hasPrefixV(s, 'V') && allowed.Has(CoerceUppercaseV):
+		s = s[1:]
+		applied |= CoerceUppercaseV
+	}
+
+	// Split off any pre-release or build, coercions only apply to the numeric components
+	core, suffix := s, ""
+	if end := strings.IndexAny(s, "-+"); end != -1 {
+		core, suffix = s[:end], s[end:]
+	}
+
+	parts := strings.Split(core, ".")
+
+	if allowed.Has(CoerceLeadingZeros) {
+		for i, part := range parts {
+			if len(part) > 1 && part[0] == '0' && isNumeric(part) {
+				parts[i] = strings.TrimLeft(part, "0")
+				if parts[i] == "" {
+					parts[i] = "0"
+				}
+				applied |= CoerceLeadingZeros
+			}
+		}
+	}
+
+	if len(parts) < 3 && allowed.Has(CoercePadMissing) {
+		for len(parts) < 3 {
+			parts = append(parts, "0")
+		}
+		applied |= CoercePadMissing
+	}
+
+	if len(parts) > 3 && allowed.Has(CoerceTruncateExtra) && !slices.ContainsFunc(parts[3:], isNotNumeric) {
+		parts = parts[:3]
+		applied |= CoerceTruncateExtra
+	}
+
+	v, err := Parse(strings.Join(parts, ".") + suffix)
+	if err != nil {
+		return Version{}, 0, fmt.Errorf("could not coerce %q into a semantic version: %w", text, err)
+	}
+
+	return v, applied, nil
+}
+
+// isNotNumeric is the inverse of isNumeric.
+func isNotNumeric(s string) bool {
+	return !isNumeric(s)
+}
+
+// prefixLen returns the length of any non-numeric prefix before the version in s,
+// or -1 if s has no such prefix.
+//
+// The version preferably starts at a word boundary, so that "app2-1.2.3" has the
+// prefix "app2-" rather than "app". Failing that it starts at the first digit, so
+// "go1.21.3" has the prefix "go". A '.' is not a word boundary, so a version never
+// starts part way through a dotted number such as the "21.3" of "go1.21.3".
+//
+// Leading whitespace and a 'v' or 'V' are not part of a prefix as they are handled
+// by other coercions.
+func prefixLen(s string) int {
+	if s == "" || isDigit(s[0]) || hasPrefixV(s, 'v') || hasPrefixV(s, 'V') {
+		return -1
+	}
+
+	if first, _ := utf8.DecodeRuneInString(s); unicode.IsSpace(first) {
+		return -1
+	}
+
+	for i := 1; i < len(s); i++ {
+		if prev := s[i-1]; isAlphanumeric(prev) || prev == '.' {
+			continue
+		}
+
+		if isDigit(s[i]) || hasPrefixV(s[i:], 'v') || hasPrefixV(s[i:], 'V') {
+			return i
+		}
+	}
+
+	return strings.IndexAny(s, "0123456789")
+}
+
+// hasPrefixV reports whether s starts with v immediately followed by a digit.
+func hasPrefixV(s string, v byte) bool {
+	return len(s) > 1 && s[0] == v && isDigit(s[1])
+}
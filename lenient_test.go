@@ -0,0 +1,351 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestParseLenient(t *testing.T) {
+	tests := []struct {
+		text        string
+		want        semver.Version
+		allowed     semver.Coercion
+		wantApplied semver.Coercion
+		wantErr     bool
+	}{
+		{
+			text:        "1.2.3",
+			allowed:     semver.CoerceAll,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: 0,
+		},
+		{
+			text:        "v1.2.3-rc.1+build",
+			allowed:     0,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build"},
+			wantApplied: 0,
+		},
+		{
+			text:        " 1.2.3 ",
+			allowed:     semver.CoerceTrimSpace,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceTrimSpace,
+		},
+		{
+			text:    " 1.2.3 ",
+			allowed: semver.CoerceAll &^ semver.CoerceTrimSpace,
+			wantErr: true,
+		},
+		{
+			text:        "V1.2.3",
+			allowed:     semver.CoerceUppercaseV,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceUppercaseV,
+		},
+		{
+			text:    "V1.2.3",
+			allowed: semver.CoercePadMissing,
+			wantErr: true,
+		},
+		{
+			text:        "release-1.2.3",
+			allowed:     semver.CoerceStripPrefix,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:        "version 1.2.3-beta.2",
+			allowed:     semver.CoerceStripPrefix,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "beta.2"},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:    "release-1.2.3",
+			allowed: semver.CoerceUppercaseV,
+			wantErr: true,
+		},
+		{
+			text:        "app2-1.2.3",
+			allowed:     semver.CoerceAll,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:        "go1.21.3",
+			allowed:     semver.CoerceAll,
+			want:        semver.Version{Major: 1, Minor: 21, Patch: 3},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:        "vv1.2.3",
+			allowed:     semver.CoerceAll,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:        "rc.1-2.0.0",
+			allowed:     semver.CoerceStripPrefix,
+			want:        semver.Version{Major: 2},
+			wantApplied: semver.CoerceStripPrefix,
+		},
+		{
+			text:    "release-",
+			allowed: semver.CoerceAll,
+			wantErr: true,
+		},
+		{
+			text:    " 1.2.3",
+			allowed: semver.CoerceStripPrefix,
+			wantErr: true,
+		},
+		{
+			text:    "V1.2.3",
+			allowed: semver.CoerceStripPrefix,
+			wantErr: true,
+		},
+		{
+			text:    "release-V1.2.3",
+			allowed: semver.CoerceStripPrefix,
+			wantErr: true,
+		},
+		{
+			text:        "release-V1.2.3",
+			allowed:     semver.CoerceStripPrefix | semver.CoerceUppercaseV,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceStripPrefix | semver.CoerceUppercaseV,
+		},
+		{
+			text:        "1.2",
+			allowed:     semver.CoercePadMissing,
+			want:        semver.Version{Major: 1, Minor: 2},
+			wantApplied: semver.CoercePadMissing,
+		},
+		{
+			text:        "v1",
+			allowed:     semver.CoercePadMissing,
+			want:        semver.Version{Major: 1},
+			wantApplied: semver.CoercePadMissing,
+		},
+		{
+			text:        "1.2-rc.1",
+			allowed:     semver.CoercePadMissing,
+			want:        semver.Version{Major: 1, Minor: 2, Prerelease: "rc.1"},
+			wantApplied: semver.CoercePadMissing,
+		},
+		{
+			text:    "1.2",
+			allowed: semver.CoerceAll &^ semver.CoercePadMissing,
+			wantErr: true,
+		},
+		{
+			text:        "1.2.3.4",
+			allowed:     semver.CoerceTruncateExtra,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceTruncateExtra,
+		},
+		{
+			text:    "1.2.3.4",
+			allowed: semver.CoerceAll &^ semver.CoerceTruncateExtra,
+			wantErr: true,
+		},
+		{
+			text:    "1.2.3.DEV",
+			allowed: semver.CoerceAll,
+			wantErr: true,
+		},
+		{
+			text:        "1.02.3",
+			allowed:     semver.CoerceLeadingZeros,
+			want:        semver.Version{Major: 1, Minor: 2, Patch: 3},
+			wantApplied: semver.CoerceLeadingZeros,
+		},
+		{
+			text:        "01.00.003",
+			allowed:     semver.CoerceLeadingZeros,
+			want:        semver.Version{Major: 1, Minor: 0, Patch: 3},
+			wantApplied: semver.CoerceLeadingZeros,
+		},
+		{
+			text:    "1.02.3",
+			allowed: semver.CoerceAll &^ semver.CoerceLeadingZeros,
+			wantErr: true,
+		},
+		{
+			text:        " Release-v01.2 ",
+			allowed:     semver.CoerceAll,
+			want:        semver.Version{Major: 1, Minor: 2},
+			wantApplied: semver.CoerceTrimSpace | semver.CoerceStripPrefix | semver.CoerceLeadingZeros | semver.CoercePadMissing,
+		},
+		{
+			text:    "not a version",
+			allowed: semver.CoerceAll,
+			wantErr: true,
+		},
+		{
+			text:    "",
+			allowed: semver.CoerceAll,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, applied, err := semver.ParseLenient(tt.text, tt.allowed)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLenient(%q, %v) returned an unexpected error: %v", tt.text, tt.allowed, err)
+			}
+
+			if err != nil {
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Errorf("error does not wrap ErrInvalidVersion: %v", err)
+				}
+				return
+			}
+
+			if got != tt.want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, tt.want)
+			}
+
+			if applied != tt.wantApplied {
+				t.Errorf("wrong coercions applied: got %v, wanted %v", applied, tt.wantApplied)
+			}
+
+			if !tt.allowed.Has(applied) {
+				t.Errorf("applied coercions %v that were not allowed (%v)", applied, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestCoercionString(t *testing.T) {
+	tests := []struct {
+		want     string
+		coercion semver.Coercion
+	}{
+		{coercion: 0, want: "None"},
+		{coercion: semver.CoerceTrimSpace, want: "TrimSpace"},
+		{coercion: semver.CoercePadMissing | semver.CoerceTrimSpace, want: "TrimSpace|PadMissing"},
+		{coercion: semver.CoerceAll, want: "TrimSpace|UppercaseV|StripPrefix|PadMissing|TruncateExtra|LeadingZeros"},
+		{coercion: semver.CoerceLeadingZeros | 1<<10, want: "LeadingZeros|Coercion(0x400)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.coercion.String(); got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// FuzzParseLenient fuzzes ParseLenient to ensure it never panics, that anything
+// it does accept is a valid version that strict parsing agrees with, and that the
+// numbers in that version are the leading numbers of a whole dotted number in s,
+// rather than some part of one.
+func FuzzParseLenient(f *testing.F) {
+	for str := range valid {
+		f.Add(str)
+	}
+	for _, str := range invalid {
+		f.Add(str)
+	}
+	f.Add(" release-V01.2.3.4 ")
+	f.Add("go1.21.3")
+	f.Add("vv1.2.3")
+	f.Add("app2-1.2.3")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		v, _, err := semver.ParseLenient(s, semver.CoerceAll)
+		if err != nil {
+			return
+		}
+
+		reParsed, err := semver.Parse(v.String())
+		if err != nil {
+			t.Fatalf("ParseLenient(%q) returned %#v which does not parse: %v", s, v, err)
+		}
+
+		if reParsed != v {
+			t.Fatalf("\nReparsed:\t%#v\nOriginal:\t%#v\n", reParsed, v)
+		}
+
+		got := []string{
+			strconv.FormatUint(uint64(v.Major), 10),
+			strconv.FormatUint(uint64(v.Minor), 10),
+			strconv.FormatUint(uint64(v.Patch), 10),
+		}
+		first, boundary := leadingNumbers(s)
+		if !slices.Equal(got, first) && !slices.Equal(got, boundary) {
+			t.Fatalf("ParseLenient(%q) returned %s, wanted the numbers %v or %v", s, v, first, boundary)
+		}
+	})
+}
+
+// leadingNumbers returns the major, minor and patch numbers ParseLenient may take from
+// text, padded or truncated to 3 and without leading zeros. That is those of the first
+// dotted number in text, and those of the first dotted number at a word boundary, which
+// may be preceded by a 'v' or 'V'.
+func leadingNumbers(text string) (first, boundary []string) {
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	isWordChar := func(c byte) bool {
+		return isDigit(c) || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c == '.'
+	}
+
+	for i := 0; i < len(text) && boundary == nil; {
+		if !isDigit(text[i]) {
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(text) && (isDigit(text[end]) || (text[end] == '.' && end+1 < len(text) && isDigit(text[end+1]))) {
+			end++
+		}
+
+		numbers := strings.Split(text[i:end], ".")
+		for len(numbers) < 3 {
+			numbers = append(numbers, "0")
+		}
+		numbers = numbers[:3]
+		for j, number := range numbers {
+			if numbers[j] = strings.TrimLeft(number, "0"); numbers[j] == "" {
+				numbers[j] = "0"
+			}
+		}
+
+		if first == nil {
+			first = numbers
+		}
+
+		start := i
+		if start > 0 && (text[start-1] == 'v' || text[start-1] == 'V') {
+			start--
+		}
+		if start == 0 || !isWordChar(text[start-1]) {
+			boundary = numbers
+		}
+
+		i = end
+	}
+
+	return first, boundary
+}
+
+func ExampleParseLenient() {
+	v, applied, err := semver.ParseLenient(" release-1.2 ", semver.CoerceAll)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v)
+	fmt.Println(applied)
+	// Output:
+	// 1.2.0
+	// TrimSpace|StripPrefix|PadMissing
+}
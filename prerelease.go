@@ -0,0 +1,201 @@
+package semver
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Identifier is a single dot separated pre-release identifier, as defined in
+// [semver 2.0.0 spec §9].
+//
+// An identifier is either numeric, made up entirely of digits e.g. the "1" in "rc.1",
+// or alphanumeric e.g. the "rc". The distinction matters as numeric identifiers
+// are compared numerically and always have lower precedence than alphanumeric ones.
+//
+// The zero value of an Identifier is not valid, use [ParseIdentifier] or [NumericIdentifier]
+// to create one, or [Version.PrereleaseIdentifiers] to get those of an existing Version.
+//
+// [semver 2.0.0 spec §9]: https://semver.org/#spec-item-9
+type Identifier struct {
+	text     string // The identifier as it appears in a pre-release
+	value    uint64 // The value of a numeric identifier, if it fits
+	numeric  bool   // Whether text is entirely digits
+	overflow bool   // Whether a numeric identifier is too large for value
+}
+
+// ParseIdentifier parses and validates a single pre-release identifier.
+//
+// The identifier must be non-empty, made up only of ASCII alphanumerics and
+// hyphens, and if it is numeric it must not have a leading zero. If it is not,
+// the error will be a [*ParseError] wrapping [ErrInvalidVersion].
+//
+// Numeric identifiers may be arbitrarily large, see [Identifier.Uint].
+//
+//	id, _ := ParseIdentifier("rc")
+//	id.IsNumeric() // false
+func ParseIdentifier(text string) (Identifier, error) {
+	for i := range len(text) {
+		if !isIdentifierChar(text[i]) {
+			return Identifier{}, &ParseError{
+				Err:       ErrInvalidVersion,
+				Input:     text,
+				Component: pre,
+				Reason:    reasonInvalidChar,
+				Offset:    i,
+			}
+		}
+	}
+
+	if reason := checkIdentifier(text, true); reason != "" {
+		return Identifier{}, &ParseError{
+			Err:       ErrInvalidVersion,
+			Input:     text,
+			Component: pre,
+			Reason:    reason,
+			Offset:    0,
+		}
+	}
+
+	return newIdentifier(text), nil
+}
+
+// NumericIdentifier returns the numeric pre-release identifier with the value n.
+//
+//	NumericIdentifier(1).String() // "1"
+func NumericIdentifier(n uint64) Identifier {
+	return Identifier{text: strconv.FormatUint(n, 10), value: n, numeric: true}
+}
+
+// String returns the identifier as it appears in a pre-release.
+func (i Identifier) String() string {
+	return i.text
+}
+
+// IsNumeric reports whether the identifier is numeric, i.e. made up entirely of digits.
+func (i Identifier) IsNumeric() bool {
+	return i.numeric
+}
+
+// Uint returns the value of a numeric identifier.
+//
+// The spec places no limit on the size of numeric identifiers, so ok is false
+// if the identifier is alphanumeric or its value does not fit in a uint64.
+func (i Identifier) Uint() (n uint64, ok bool) {
+	if !i.numeric || i.overflow {
+		return 0, false
+	}
+	return i.value, true
+}
+
+// Compare returns an integer comparing two identifiers by precedence, in the
+// same manner as [Compare] does for versions.
+//
+// Numeric identifiers are compared numerically (regardless of size) and have
+// lower precedence than alphanumeric ones, which are compared lexically in
+// ASCII sort order.
+func (i Identifier) Compare(other Identifier) int {
+	return compareIdentifier(i.text, other.text)
+}
+
+// PrereleaseIdentifiers returns the dot separated identifiers that make up
+// the pre-release of v, or nil if v has no pre-release.
+//
+//	v := Version{Major: 1, Prerelease: "rc.1"}
+//	ids := v.PrereleaseIdentifiers()
+//	ids[0].String()       // "rc"
+//	n, _ := ids[1].Uint() // 1
+//
+// The identifiers are not validated, so if v was constructed by hand with an
+// invalid pre-release they may not be valid either.
+func (v Version) PrereleaseIdentifiers() []Identifier {
+	if v.Prerelease == "" {
+		return nil
+	}
+
+	ids := make([]Identifier, 0, strings.Count(v.Prerelease, ".")+1)
+	for text := range strings.SplitSeq(v.Prerelease, ".") {
+		ids = append(ids, newIdentifier(text))
+	}
+
+	return ids
+}
+
+// JoinIdentifiers joins identifiers with '.' to form a pre-release, the inverse
+// of [Version.PrereleaseIdentifiers].
+//
+//	rc, _ := ParseIdentifier("rc")
+//	JoinIdentifiers(rc, NumericIdentifier(2)) // "rc.2"
+func JoinIdentifiers(ids ...Identifier) string {
+	var b strings.Builder
+	for i, id := range ids {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(id.text)
+	}
+	return b.String()
+}
+
+// newIdentifier builds an Identifier from text, without validating it.
+func newIdentifier(text string) Identifier {
+	if !isNumeric(text) {
+		return Identifier{text: text}
+	}
+
+	n, err := strconv.ParseUint(text, 10, 64)
+	if err != nil {
+		// Digits are guaranteed so the only possible error is overflow
+		return Identifier{text: text, numeric: true, overflow: true}
+	}
+
+	return Identifier{text: text, value: n, numeric: true}
+}
+
+// checkLabel checks that label is empty or a valid dot separated series of
+// pre-release identifiers, for use in the pre-release bumps.
+func checkLabel(label string) error {
+	if label == "" {
+		return nil
+	}
+	return checkIdentifiers(label, true)
+}
+
+// startPrerelease returns the first pre-release in the series identified by label.
+func startPrerelease(label string) string {
+	if label == "" {
+		return "0"
+	}
+	return label + ".0"
+}
+
+// nextPrerelease returns the pre-release following prerelease in the series identified
+// by label, see [BumpPrerelease].
+func nextPrerelease(prerelease, label string) string {
+	if label != "" && prerelease != label && !strings.HasPrefix(prerelease, label+".") {
+		return startPrerelease(label)
+	}
+
+	ids := strings.Split(prerelease, ".")
+	for i := len(ids) - 1; i >= 0; i-- {
+		if isNumeric(ids[i]) {
+			ids[i] = incrementDigits(ids[i])
+			return strings.Join(ids, ".")
+		}
+	}
+
+	return prerelease + ".0"
+}
+
+// incrementDigits adds one to the decimal number in digits, which may be
+// arbitrarily large.
+func incrementDigits(digits string) string {
+	b := []byte(digits)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
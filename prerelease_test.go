@@ -0,0 +1,232 @@
+package semver_test
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestParseIdentifier(t *testing.T) {
+	tests := []struct {
+		text    string
+		value   uint64
+		numeric bool
+		fits    bool
+	}{
+		{text: "rc", numeric: false},
+		{text: "alpha-1", numeric: false},
+		{text: "0a", numeric: false},
+		{text: "-", numeric: false},
+		{text: "0", numeric: true, fits: true, value: 0},
+		{text: "1", numeric: true, fits: true, value: 1},
+		{text: "18446744073709551615", numeric: true, fits: true, value: 18446744073709551615},
+		{text: "18446744073709551616", numeric: true, fits: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			id, err := semver.ParseIdentifier(tt.text)
+			if err != nil {
+				t.Fatalf("ParseIdentifier(%q) returned an error: %v", tt.text, err)
+			}
+
+			if id.String() != tt.text {
+				t.Errorf("wrong String: got %q, wanted %q", id.String(), tt.text)
+			}
+
+			if id.IsNumeric() != tt.numeric {
+				t.Errorf("wrong IsNumeric: got %v, wanted %v", id.IsNumeric(), tt.numeric)
+			}
+
+			n, ok := id.Uint()
+			if ok != tt.fits {
+				t.Errorf("wrong ok from Uint: got %v, wanted %v", ok, tt.fits)
+			}
+
+			if n != tt.value {
+				t.Errorf("wrong value from Uint: got %d, wanted %d", n, tt.value)
+			}
+		})
+	}
+}
+
+func TestParseIdentifierInvalid(t *testing.T) {
+	tests := []struct {
+		text   string
+		reason string
+		offset int
+	}{
+		{text: "", reason: "empty identifier", offset: 0},
+		{text: "01", reason: "leading zero in numeric identifier", offset: 0},
+		{text: "rc.1", reason: "invalid character", offset: 2},
+		{text: "rc_1", reason: "invalid character", offset: 2},
+		{text: "beta+1", reason: "invalid character", offset: 4},
+		{text: "ü", reason: "invalid character", offset: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			_, err := semver.ParseIdentifier(tt.text)
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Fatalf("ParseIdentifier(%q) did not return ErrInvalidVersion, got %v", tt.text, err)
+			}
+
+			var parseErr *semver.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("ParseIdentifier(%q) did not return a *ParseError, got %T", tt.text, err)
+			}
+
+			if parseErr.Reason != tt.reason {
+				t.Errorf("wrong reason: got %q, wanted %q", parseErr.Reason, tt.reason)
+			}
+
+			if parseErr.Offset != tt.offset {
+				t.Errorf("wrong offset: got %d, wanted %d", parseErr.Offset, tt.offset)
+			}
+
+			if parseErr.Component != "prerelease" {
+				t.Errorf("wrong component: got %q, wanted %q", parseErr.Component, "prerelease")
+			}
+		})
+	}
+}
+
+func TestNumericIdentifier(t *testing.T) {
+	for _, n := range []uint64{0, 1, 42, 18446744073709551615} {
+		id := semver.NumericIdentifier(n)
+
+		if !id.IsNumeric() {
+			t.Errorf("NumericIdentifier(%d) is not numeric", n)
+		}
+
+		if got, ok := id.Uint(); !ok || got != n {
+			t.Errorf("NumericIdentifier(%d).Uint() = (%d, %v), wanted (%d, true)", n, got, ok, n)
+		}
+
+		parsed, err := semver.ParseIdentifier(id.String())
+		if err != nil {
+			t.Fatalf("ParseIdentifier(%q) returned an error: %v", id.String(), err)
+		}
+
+		if parsed != id {
+			t.Errorf("NumericIdentifier(%d) and ParseIdentifier(%q) differ: %#v != %#v", n, id.String(), id, parsed)
+		}
+	}
+}
+
+func TestIdentifierCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "1", b: "1", want: 0},
+		{a: "1", b: "2", want: -1},
+		{a: "10", b: "9", want: 1},
+		{a: "18446744073709551616", b: "18446744073709551615", want: 1},
+		{a: "1", b: "alpha", want: -1},
+		{a: "alpha", b: "1", want: 1},
+		{a: "alpha", b: "beta", want: -1},
+		{a: "RC", b: "rc", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+" vs "+tt.b, func(t *testing.T) {
+			a, err := semver.ParseIdentifier(tt.a)
+			if err != nil {
+				t.Fatalf("ParseIdentifier(%q) returned an error: %v", tt.a, err)
+			}
+
+			b, err := semver.ParseIdentifier(tt.b)
+			if err != nil {
+				t.Fatalf("ParseIdentifier(%q) returned an error: %v", tt.b, err)
+			}
+
+			if got := a.Compare(b); got != tt.want {
+				t.Errorf("Compare(%q, %q): got %d, wanted %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrereleaseIdentifiers(t *testing.T) {
+	tests := []struct {
+		prerelease string
+		want       []string
+	}{
+		{prerelease: "", want: nil},
+		{prerelease: "rc", want: []string{"rc"}},
+		{prerelease: "rc.1", want: []string{"rc", "1"}},
+		{prerelease: "alpha.beta.0.x-y", want: []string{"alpha", "beta", "0", "x-y"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prerelease, func(t *testing.T) {
+			v := semver.Version{Major: 1, Prerelease: tt.prerelease, Build: "ignored.1"}
+
+			ids := v.PrereleaseIdentifiers()
+
+			var got []string
+			for _, id := range ids {
+				got = append(got, id.String())
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+
+			if joined := semver.JoinIdentifiers(ids...); joined != tt.prerelease {
+				t.Errorf("JoinIdentifiers did not round trip: got %q, wanted %q", joined, tt.prerelease)
+			}
+		})
+	}
+}
+
+// FuzzPrereleaseIdentifiers ensures that the identifiers of any valid version
+// are each valid, compare consistently with Compare and round trip through JoinIdentifiers.
+func FuzzPrereleaseIdentifiers(f *testing.F) {
+	for str := range valid {
+		f.Add(str)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		v, err := semver.Parse(s)
+		if err != nil {
+			return
+		}
+
+		ids := v.PrereleaseIdentifiers()
+		for _, id := range ids {
+			parsed, err := semver.ParseIdentifier(id.String())
+			if err != nil {
+				t.Fatalf("identifier %q of valid version %q is invalid: %v", id, s, err)
+			}
+
+			if parsed != id {
+				t.Fatalf("identifier %#v and its parsed form %#v differ", id, parsed)
+			}
+		}
+
+		if joined := semver.JoinIdentifiers(ids...); joined != v.Prerelease {
+			t.Fatalf("JoinIdentifiers did not round trip: got %q, wanted %q", joined, v.Prerelease)
+		}
+	})
+}
+
+func ExampleVersion_PrereleaseIdentifiers() {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.4"}
+
+	for _, id := range v.PrereleaseIdentifiers() {
+		if n, ok := id.Uint(); ok {
+			fmt.Printf("numeric: %d\n", n)
+		} else {
+			fmt.Printf("alphanumeric: %s\n", id)
+		}
+	}
+
+	// Output:
+	// alphanumeric: rc
+	// numeric: 4
+}
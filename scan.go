@@ -0,0 +1,231 @@
+package semver
+
+// Here primarily to avoid typos.
+const (
+	major = "major"
+	minor = "minor"
+	patch = "patch"
+	pre   = "prerelease"
+	build = "build"
+)
+
+// Reasons for a scan failure, used in a ParseError.
+const (
+	reasonEOF            = "unexpected end of input"
+	reasonExpectedDigit  = "expected a digit"
+	reasonExpectedDot    = "expected '.'"
+	reasonLeadingZero    = "leading zero in numeric identifier"
+	reasonEmptyIdent     = "empty identifier"
+	reasonInvalidChar    = "invalid character"
+	reasonOverflow       = "value overflows uint"
+	reasonPrefix         = "leading 'v' not allowed"
+	reasonUnexpectedChar = "unexpected character"
+)
+
+// components holds the raw text of each part of a semantic version, as
+// found by scan. All strings are substrings of the scanned text so no
+// allocation is necessary.
+type components struct {
+	major    string // Major version digits
+	minor    string // Minor version digits
+	patch    string // Patch version digits
+	pre      string // Pre-release, without the leading '-'
+	build    string // Build metadata, without the leading '+'
+	prefixed bool   // Whether the text had a leading 'v'
+}
+
+// scanError describes where and why a scan failed.
+//
+// It is deliberately not an error so that failing scans (e.g. in IsValid)
+// need not allocate, Parse turns it into a [ParseError].
+type scanError struct {
+	component string // The component in which the failure occurred
+	reason    string // Why the scan failed, empty if it succeeded
+	offset    int    // Byte offset of the offending character in the scanned text
+}
+
+// failed reports whether the scan failed.
+func (e scanError) failed() bool {
+	return e.reason != ""
+}
+
+// scan is a single pass scanner over text, splitting a semantic version into
+// its components and validating it against the grammar in the [semver 2.0.0 spec]
+// (plus our optional leading 'v') as it goes.
+//
+// [semver 2.0.0 spec]: https://semver.org/#backusnaur-form-grammar-for-valid-semver-versions
+func scan(text string) (components, scanError) {
+	var c components
+
+	s := text
+	if s != "" && s[0] == 'v' {
+		c.prefixed = true
+		s = s[1:]
+	}
+
+	var nums [3]string
+	for i, name := range [...]string{major, minor, patch} {
+		if i > 0 {
+			switch {
+			case s == "":
+				return components{}, scanError{component: name, reason: reasonEOF, offset: len(text)}
+			case s[0] != '.':
+				return components{}, scanError{component: name, reason: reasonExpectedDot, offset: len(text) - len(s)}
+			}
+			s = s[1:]
+		}
+
+		n, reason := scanNumber(s)
+		if reason != "" {
+			return components{}, scanError{component: name, reason: reason, offset: len(text) - len(s) + n}
+		}
+		nums[i], s = s[:n], s[n:]
+	}
+	c.major, c.minor, c.patch = nums[0], nums[1], nums[2]
+
+	if s != "" && s[0] == '-' {
+		s = s[1:]
+		n, reason := scanIdentifiers(s, true)
+		if reason != "" {
+			return components{}, scanError{component: pre, reason: reason, offset: len(text) - len(s) + n}
+		}
+		c.pre, s = s[:n], s[n:]
+
+		if s != "" && s[0] != '+' {
+			return components{}, scanError{component: pre, reason: reasonInvalidChar, offset: len(text) - len(s)}
+		}
+	}
+
+	if s != "" && s[0] == '+' {
+		s = s[1:]
+		n, reason := scanIdentifiers(s, false)
+		if reason != "" {
+			return components{}, scanError{component: build, reason: reason, offset: len(text) - len(s) + n}
+		}
+		c.build, s = s[:n], s[n:]
+
+		if s != "" {
+			return components{}, scanError{component: build, reason: reasonInvalidChar, offset: len(text) - len(s)}
+		}
+	}
+
+	if s != "" {
+		// Trailing junk after the patch version
+		return components{}, scanError{component: patch, reason: reasonUnexpectedChar, offset: len(text) - len(s)}
+	}
+
+	return c, scanError{}
+}
+
+// scanNumber scans a numeric identifier from the start of s, returning the
+// number of bytes it occupies.
+//
+// A numeric identifier is either a single '0', or a run of digits not starting with 0.
+//
+// If s does not start with a valid numeric identifier, the reason is returned
+// along with the offset into s of the offending character.
+func scanNumber(s string) (n int, reason string) {
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+
+	switch {
+	case n == 0 && s == "":
+		return 0, reasonEOF
+	case n == 0:
+		return 0, reasonExpectedDigit
+	case n > 1 && s[0] == '0':
+		return 0, reasonLeadingZero
+	default:
+		return n, ""
+	}
+}
+
+// scanIdentifiers scans a non-empty, dot separated series of identifiers from
+// the start of s, returning the number of bytes they occupy.
+//
+// Each identifier must be non-empty and made up of only ASCII alphanumerics and
+// hyphens. If prerelease is true, identifiers made up entirely of digits must
+// also not have leading zeroes, as the spec does not require this of build metadata.
+//
+// Scanning stops at the first byte that cannot appear in an identifier, it is up
+// to the caller to decide whether that is valid.
+//
+// If an identifier is invalid, the reason is returned along with the offset
+// into s of the start of that identifier.
+func scanIdentifiers(s string, prerelease bool) (n int, reason string) {
+	start := 0 // Start of the current identifier
+	for ; n < len(s); n++ {
+		char := s[n]
+		if char == '.' {
+			if reason = checkIdentifier(s[start:n], prerelease); reason != "" {
+				return start, reason
+			}
+			start = n + 1
+			continue
+		}
+		if !isIdentifierChar(char) {
+			break
+		}
+	}
+
+	if reason = checkIdentifier(s[start:n], prerelease); reason != "" {
+		return start, reason
+	}
+
+	return n, ""
+}
+
+// checkIdentifier checks a single identifier, already known to contain only valid
+// characters, is non-empty and (if prerelease is true) is not a numeric identifier
+// with a leading zero.
+//
+// It returns the reason the identifier is invalid, or "" if it is valid.
+func checkIdentifier(ident string, prerelease bool) string {
+	if ident == "" {
+		return reasonEmptyIdent
+	}
+	if prerelease && len(ident) > 1 && ident[0] == '0' && isNumeric(ident) {
+		return reasonLeadingZero
+	}
+	return ""
+}
+
+// isIdentifierChar reports whether c may appear in a pre-release or build identifier.
+func isIdentifierChar(c byte) bool {
+	return isDigit(c) || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || c == '-'
+}
+
+// validIdentifiers reports whether s is entirely a valid, non-empty, dot separated
+// series of pre-release (if prerelease is true) or build identifiers.
+func validIdentifiers(s string, prerelease bool) bool {
+	_, reason := scanAllIdentifiers(s, prerelease)
+	return reason == ""
+}
+
+// checkIdentifiers returns a [*ParseError] describing why s is not entirely a valid,
+// non-empty, dot separated series of pre-release (if prerelease is true) or build
+// identifiers, or nil if it is.
+func checkIdentifiers(s string, prerelease bool) error {
+	component := build
+	if prerelease {
+		component = pre
+	}
+
+	if n, reason := scanAllIdentifiers(s, prerelease); reason != "" {
+		return newParseError(s, scanError{component: component, reason: reason, offset: n})
+	}
+
+	return nil
+}
+
+// scanAllIdentifiers is like scanIdentifiers but requires the identifiers to
+// make up the whole of s, returning the offset of the first invalid character
+// if they do not.
+func scanAllIdentifiers(s string, prerelease bool) (n int, reason string) {
+	n, reason = scanIdentifiers(s, prerelease)
+	if reason == "" && n != len(s) {
+		reason = reasonInvalidChar
+	}
+	return n, reason
+}
@@ -6,26 +6,8 @@
 package semver // import "go.followtheprocess.codes/semver"
 
 import (
-	"fmt"
-	"regexp"
 	"strconv"
-)
-
-// Here primarily to avoid typos.
-const (
-	major = "major"
-	minor = "minor"
-	patch = "patch"
-	pre   = "pre"
-	build = "build"
-
-	groups = 5 // major, minor, patch, pre, build
-)
-
-// See https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
-// only difference we've made is this one supports an optional 'v' on the front.
-var semVerRegex = regexp.MustCompile(
-	`^v?(?P<major>0|[1-9]\d*)\.(?P<minor>0|[1-9]\d*)\.(?P<patch>0|[1-9]\d*)(?:-(?P<pre>(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+(?P<build>[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
+	"strings"
 )
 
 // Version encodes a semantic version.
@@ -42,14 +24,7 @@ type Version struct {
 //	v := Version{Major: 1, Minor: 2, Patch: 3}
 //	fmt.Println(v) // "1.2.3"
 func (v Version) String() string {
-	base := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
-	if v.Prerelease != "" {
-		base += "-" + v.Prerelease
-	}
-	if v.Build != "" {
-		base += "+" + v.Build
-	}
-	return base
+	return string(v.appendTo(make([]byte, 0, v.size())))
 }
 
 // Tag creates a string representation of the version suitable for git tags
@@ -61,9 +36,82 @@ func (v Version) Tag() string {
 	return "v" + v.String()
 }
 
+// appendTo appends the canonical string form of v to b, returning the extended buffer.
+func (v Version) appendTo(b []byte) []byte {
+	b = strconv.AppendUint(b, uint64(v.Major), 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, uint64(v.Minor), 10)
+	b = append(b, '.')
+	b = strconv.AppendUint(b, uint64(v.Patch), 10)
+	if v.Prerelease != "" {
+		b = append(b, '-')
+		b = append(b, v.Prerelease...)
+	}
+	if v.Build != "" {
+		b = append(b, '+')
+		b = append(b, v.Build...)
+	}
+	return b
+}
+
+// size returns a good guess at the length of the canonical string form of v,
+// for pre-sizing buffers.
+func (v Version) size() int {
+	const overhead = 16 // Room for typical major, minor and patch numbers and the separators
+	return overhead + len(v.Prerelease) + len(v.Build)
+}
+
+// Release returns v with its pre-release and build metadata removed, i.e. the
+// version that v is a pre-release (or build) of.
+//
+//	v := Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+//	v.Release() // 1.2.3
+func (v Version) Release() Version {
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+}
+
+// WithPrerelease returns v with its pre-release replaced by prerelease, which should
+// not include the leading '-'. An empty prerelease removes any existing pre-release.
+//
+// If prerelease is not a valid dot separated series of pre-release identifiers, the
+// error will be a [*ParseError] wrapping [ErrInvalidVersion].
+//
+//	v := Version{Major: 1, Minor: 2, Patch: 3}
+//	rc, _ := v.WithPrerelease("rc.1") // 1.2.3-rc.1
+func (v Version) WithPrerelease(prerelease string) (Version, error) {
+	if prerelease != "" {
+		if err := checkIdentifiers(prerelease, true); err != nil {
+			return Version{}, err
+		}
+	}
+	v.Prerelease = prerelease
+	return v, nil
+}
+
+// WithBuild returns v with its build metadata replaced by build, which should
+// not include the leading '+'. An empty build removes any existing build metadata.
+//
+// If build is not a valid dot separated series of build identifiers, the
+// error will be a [*ParseError] wrapping [ErrInvalidVersion].
+//
+//	v := Version{Major: 1, Minor: 2, Patch: 3}
+//	b, _ := v.WithBuild("sha.5114f85") // 1.2.3+sha.5114f85
+func (v Version) WithBuild(build string) (Version, error) {
+	if build != "" {
+		if err := checkIdentifiers(build, false); err != nil {
+			return Version{}, err
+		}
+	}
+	v.Build = build
+	return v, nil
+}
+
 // New creates and returns a new Version.
 // Numeric parts are unsigned integers so that e.g -1 becomes a compile time error
 //
+// Note the build metadata comes before the pre-release, and neither is validated,
+// see [NewVersion] for a constructor that does both the other way round.
+//
 //	v := New(1, 7, 6, "", "")
 //	Version{Major: 1, Minor: 7, Patch: 6, Prerelease: "", Build: ""}
 func New(major, minor, patch uint, build, pre string) Version {
@@ -76,49 +124,160 @@ func New(major, minor, patch uint, build, pre string) Version {
 	}
 }
 
+// NewVersion creates and returns a new Version, validating it with [Version.Validate].
+//
+// Unlike [New], the pre-release comes before the build metadata, matching both the
+// Version struct and the string form. Neither should include their leading '-' or '+'
+// and either may be empty.
+//
+//	v, _ := NewVersion(1, 2, 3, "rc.1", "build.5")
+//	fmt.Println(v) // "1.2.3-rc.1+build.5"
+func NewVersion(major, minor, patch uint, prerelease, build string) (Version, error) {
+	v := Version{
+		Prerelease: prerelease,
+		Build:      build,
+		Major:      major,
+		Minor:      minor,
+		Patch:      patch,
+	}
+
+	if err := v.Validate(); err != nil {
+		return Version{}, err
+	}
+
+	return v, nil
+}
+
+// Validate reports whether v is a valid semantic version, i.e. whether its String
+// form would be accepted by [Parse] and give back v.
+//
+// As the numeric components are always valid, this means checking the pre-release
+// and build metadata are made up of valid identifiers. If they are not, the error will
+// be a [*ParseError] wrapping [ErrInvalidVersion] whose Input is v.String(), and whose
+// Offset is that of the offending identifier within it.
+//
+//	v := Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.01"}
+//	err := v.Validate() // parse "1.2.3-rc.01": leading zero in numeric identifier in prerelease at offset 9
+func (v Version) Validate() error {
+	text := v.String()
+
+	if v.Prerelease != "" {
+		if n, reason := scanAllIdentifiers(v.Prerelease, true); reason != "" {
+			// The pre-release starts after the '-'
+			start := strings.IndexByte(text, '-') + 1
+			return newParseError(text, scanError{component: pre, reason: reason, offset: start + n})
+		}
+	}
+
+	if v.Build != "" {
+		if n, reason := scanAllIdentifiers(v.Build, false); reason != "" {
+			start := len(text) - len(v.Build)
+			return newParseError(text, scanError{component: build, reason: reason, offset: start + n})
+		}
+	}
+
+	return nil
+}
+
 // Parse creates and returns a Version from a semver string.
 //
-// If the string is not a valid semantic version, an error will be returned
+// If the string is not a valid semantic version, the error will be a [*ParseError]
+// wrapping [ErrInvalidVersion] that describes exactly where and why parsing failed. If
+// it is valid but its major, minor or patch version is too large to be represented
+// by a uint, the [*ParseError] will wrap [ErrOverflow].
+//
+// Parse accepts an optional leading 'v' e.g. "v1.2.3", use [ParseStrict] to reject it
+// or [ParseWithPrefix] to find out whether it was present.
 //
 //	v, _ := Parse("v1.8.9")
 //	Version{Major: 1, Minor: 8, Patch: 9, Prerelease: "", Build: ""}
 func Parse(text string) (Version, error) {
-	if !IsValid(text) {
-		return Version{}, fmt.Errorf("%q is not a valid semantic version", text)
+	v, _, err := parse(text, false)
+	return v, err
+}
+
+// ParseStrict is like [Parse] but rejects the optional leading 'v', accepting
+// only exactly the syntax defined by the semver spec.
+//
+// This is appropriate for e.g. validating package manifests, where "1.2.3" is a
+// version but "v1.2.3" is not.
+//
+//	_, err := ParseStrict("v1.2.3") // err != nil
+func ParseStrict(text string) (Version, error) {
+	v, _, err := parse(text, true)
+	return v, err
+}
+
+// ParseWithPrefix is like [Parse] but additionally reports whether text had
+// the optional leading 'v'.
+//
+// This allows the original text to be faithfully reproduced, with [Version.Tag]
+// if prefixed is true and [Version.String] if not.
+//
+//	v, prefixed, _ := ParseWithPrefix("v1.2.3")
+//	fmt.Println(prefixed) // true
+func ParseWithPrefix(text string) (v Version, prefixed bool, err error) {
+	return parse(text, false)
+}
+
+// parse implements Parse, ParseStrict and ParseWithPrefix, rejecting the leading 'v'
+// if strict is true.
+func parse(text string, strict bool) (Version, bool, error) {
+	c, err := scan(text)
+	if err.failed() {
+		return Version{}, false, newParseError(text, err)
 	}
 
-	groups := make(map[string]string, groups) // 5 elements (fields of Version)
-	parts := semVerRegex.FindStringSubmatch(text)
-	names := semVerRegex.SubexpNames()
+	if strict && c.prefixed {
+		return Version{}, false, newParseError(text, scanError{component: major, reason: reasonPrefix, offset: 0})
+	}
 
-	for i, part := range parts {
-		// First element in parts is the substring e.g. "v1.2.4"
-		// first element in names is empty string -> skip both
-		if i == 0 {
-			continue
-		}
-		groups[names[i]] = part
+	var (
+		nums   [3]uint
+		offset int // Offset of the current component in text
+	)
+	if c.prefixed {
+		offset++
 	}
 
-	// Errors below are ignored because they wouldn't pass the regex check if they
-	// weren't parseable numeric digits
-	majorInt, _ := strconv.ParseUint(groups[major], 10, 64) //nolint: errcheck // Must be valid digits to pass regex
-	minorInt, _ := strconv.ParseUint(groups[minor], 10, 64) //nolint: errcheck // Must be valid digits to pass regex
-	patchInt, _ := strconv.ParseUint(groups[patch], 10, 64) //nolint: errcheck // Must be valid digits to pass regex
+	names := [...]string{major, minor, patch}
+	for i, digits := range [...]string{c.major, c.minor, c.patch} {
+		// Digits are guaranteed by the scan so the only possible error is overflow
+		n, err := strconv.ParseUint(digits, 10, 0)
+		if err != nil {
+			return Version{}, false, &ParseError{
+				Err:       ErrOverflow,
+				Input:     text,
+				Component: names[i],
+				Reason:    reasonOverflow,
+				Offset:    offset,
+			}
+		}
+
+		nums[i] = uint(n)
+		offset += len(digits) + 1 // Digits and the '.'
+	}
 
 	v := Version{
-		Prerelease: groups[pre],
-		Build:      groups[build],
-		Major:      uint(majorInt),
-		Minor:      uint(minorInt),
-		Patch:      uint(patchInt),
+		Prerelease: c.pre,
+		Build:      c.build,
+		Major:      nums[0],
+		Minor:      nums[1],
+		Patch:      nums[2],
 	}
 
-	return v, nil
+	return v, c.prefixed, nil
 }
 
 // BumpMajor returns a new Version with it's major version bumped.
+//
+// If current is a pre-release of a major version e.g. "2.0.0-rc.1", that major version
+// is released instead, giving "2.0.0", in the same way as npm, Cargo and semantic-release.
 func BumpMajor(current Version) Version {
+	if current.Prerelease != "" && current.Minor == 0 && current.Patch == 0 {
+		return Version{Major: current.Major}
+	}
+
 	// Everything else set to zero value
 	return Version{
 		Major: current.Major + 1,
@@ -126,7 +285,14 @@ func BumpMajor(current Version) Version {
 }
 
 // BumpMinor returns a new Version with it's minor version bumped.
+//
+// If current is a pre-release of a minor version e.g. "1.3.0-beta", that minor version
+// is released instead, giving "1.3.0", in the same way as npm, Cargo and semantic-release.
 func BumpMinor(current Version) Version {
+	if current.Prerelease != "" && current.Patch == 0 {
+		return Version{Major: current.Major, Minor: current.Minor}
+	}
+
 	// Keep major, bump minor, everything else -> zero value
 	return Version{
 		Major: current.Major,
@@ -135,7 +301,14 @@ func BumpMinor(current Version) Version {
 }
 
 // BumpPatch returns a new Version with it's patch version bumped.
+//
+// If current is a pre-release e.g. "1.2.3-rc.1", the version is released instead,
+// giving "1.2.3", in the same way as npm, Cargo and semantic-release.
 func BumpPatch(current Version) Version {
+	if current.Prerelease != "" {
+		return Version{Major: current.Major, Minor: current.Minor, Patch: current.Patch}
+	}
+
 	// Keep major and minor, bump patch, everything else -> zero value
 	return Version{
 		Major: current.Major,
@@ -144,7 +317,107 @@ func BumpPatch(current Version) Version {
 	}
 }
 
+// BumpPrerelease returns a new Version with the next pre-release in the series
+// identified by label, in the manner of npm's semver.inc with "prerelease".
+//
+// If current is not a pre-release, the patch version is bumped and a new series
+// is started at 0: "1.2.3" -> "1.2.4-rc.0". If current is a pre-release whose
+// identifiers start with label (or label is empty), the last numeric identifier is
+// incremented, or ".0" is appended if there is none: "1.3.0-rc.1" -> "1.3.0-rc.2".
+// Otherwise a new series is started under label: "1.3.0-alpha.3" -> "1.3.0-rc.0".
+//
+// Note that starting a new series does not check that label sorts after the old
+// one, so "1.3.0-beta.1" with label "alpha" gives the lower "1.3.0-alpha.0".
+//
+// Build metadata is always dropped. If label is not a valid dot separated series
+// of pre-release identifiers, the error will be a [*ParseError] wrapping [ErrInvalidVersion].
+//
+//	v := Version{Major: 1, Minor: 3, Prerelease: "rc.1"}
+//	next, _ := BumpPrerelease(v, "rc") // 1.3.0-rc.2
+func BumpPrerelease(current Version, label string) (Version, error) {
+	if err := checkLabel(label); err != nil {
+		return Version{}, err
+	}
+
+	if current.Prerelease == "" {
+		return Version{
+			Prerelease: startPrerelease(label),
+			Major:      current.Major,
+			Minor:      current.Minor,
+			Patch:      current.Patch + 1,
+		}, nil
+	}
+
+	return Version{
+		Prerelease: nextPrerelease(current.Prerelease, label),
+		Major:      current.Major,
+		Minor:      current.Minor,
+		Patch:      current.Patch,
+	}, nil
+}
+
+// BumpPremajor returns a new Version with its major version bumped and a new
+// pre-release series started under label, like npm's semver.inc with "premajor".
+//
+// If label is empty, the pre-release is simply "0". Build metadata is dropped and
+// label is validated as in [BumpPrerelease].
+//
+//	next, _ := BumpPremajor(Version{Major: 1, Minor: 2, Patch: 3}, "rc") // 2.0.0-rc.0
+func BumpPremajor(current Version, label string) (Version, error) {
+	if err := checkLabel(label); err != nil {
+		return Version{}, err
+	}
+
+	return Version{
+		Prerelease: startPrerelease(label),
+		Major:      current.Major + 1,
+	}, nil
+}
+
+// BumpPreminor returns a new Version with its minor version bumped and a new
+// pre-release series started under label, like npm's semver.inc with "preminor".
+//
+// If label is empty, the pre-release is simply "0". Build metadata is dropped and
+// label is validated as in [BumpPrerelease].
+//
+//	next, _ := BumpPreminor(Version{Major: 1, Minor: 2, Patch: 3}, "rc") // 1.3.0-rc.0
+func BumpPreminor(current Version, label string) (Version, error) {
+	if err := checkLabel(label); err != nil {
+		return Version{}, err
+	}
+
+	return Version{
+		Prerelease: startPrerelease(label),
+		Major:      current.Major,
+		Minor:      current.Minor + 1,
+	}, nil
+}
+
+// BumpPrepatch returns a new Version with its patch version bumped and a new
+// pre-release series started under label, like npm's semver.inc with "prepatch".
+//
+// If label is empty, the pre-release is simply "0". Build metadata is dropped and
+// label is validated as in [BumpPrerelease].
+//
+//	next, _ := BumpPrepatch(Version{Major: 1, Minor: 2, Patch: 3}, "rc") // 1.2.4-rc.0
+func BumpPrepatch(current Version, label string) (Version, error) {
+	if err := checkLabel(label); err != nil {
+		return Version{}, err
+	}
+
+	return Version{
+		Prerelease: startPrerelease(label),
+		Major:      current.Major,
+		Minor:      current.Minor,
+		Patch:      current.Patch + 1,
+	}, nil
+}
+
 // IsValid returns whether or not a string is a valid semantic version.
+//
+// Because the spec places no limit on the size of the major, minor and patch versions,
+// IsValid may return true for a string that [Parse] rejects with [ErrOverflow].
 func IsValid(text string) bool {
-	return semVerRegex.MatchString(text)
+	_, err := scan(text)
+	return !err.failed()
 }
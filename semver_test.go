@@ -1,12 +1,22 @@
 package semver_test
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"regexp"
+	"strings"
 	"testing"
 
 	"go.followtheprocess.codes/semver"
 )
 
+// The regex suggested by https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
+// with the addition of an optional leading 'v', used as an oracle for the hand written parser.
+var semVerRegex = regexp.MustCompile(
+	`^v?(?P<major>0|[1-9]\d*)\.(?P<minor>0|[1-9]\d*)\.(?P<patch>0|[1-9]\d*)(?:-(?P<pre>(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?(?:\+(?P<build>[0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`,
+)
+
 // Map of valid semver strings to their expected semver.Version, no error should
 // be returned parsing these.
 var valid = map[string]semver.Version{
@@ -208,6 +218,111 @@ func TestParseInvalid(t *testing.T) {
 	}
 }
 
+func TestParseStrict(t *testing.T) {
+	for str, want := range valid {
+		t.Run(str, func(t *testing.T) {
+			got, err := semver.ParseStrict(str)
+
+			if strings.HasPrefix(str, "v") {
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Fatalf("ParseStrict(%q) did not reject the 'v' prefix, got %v", str, err)
+				}
+
+				if got != (semver.Version{}) {
+					t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, semver.Version{})
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParseStrict(%q) returned an error: %v", str, err)
+			}
+
+			if got != want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, want)
+			}
+		})
+	}
+
+	for _, str := range invalid {
+		t.Run(str, func(t *testing.T) {
+			if _, err := semver.ParseStrict(str); err == nil {
+				t.Fatalf("ParseStrict(%q) did not return an error", str)
+			}
+		})
+	}
+}
+
+func TestParseWithPrefix(t *testing.T) {
+	for str, want := range valid {
+		t.Run(str, func(t *testing.T) {
+			got, prefixed, err := semver.ParseWithPrefix(str)
+			if err != nil {
+				t.Fatalf("ParseWithPrefix(%q) returned an error: %v", str, err)
+			}
+
+			if got != want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, want)
+			}
+
+			if wantPrefixed := strings.HasPrefix(str, "v"); prefixed != wantPrefixed {
+				t.Errorf("wrong prefixed: got %v, wanted %v", prefixed, wantPrefixed)
+			}
+
+			// Should be able to faithfully reproduce the input
+			roundTrip := got.String()
+			if prefixed {
+				roundTrip = got.Tag()
+			}
+
+			if roundTrip != str {
+				t.Errorf("round trip: got %q, wanted %q", roundTrip, str)
+			}
+		})
+	}
+}
+
+func TestParseOverflow(t *testing.T) {
+	tests := []string{
+		"99999999999999999999.0.0",
+		"0.99999999999999999999.0",
+		"0.0.99999999999999999999",
+		"v18446744073709551616.0.0",
+		"1.2.18446744073709551616-rc.1+build.123",
+	}
+
+	for _, str := range tests {
+		t.Run(str, func(t *testing.T) {
+			if !semver.IsValid(str) {
+				t.Fatalf("IsValid(%q) returned false", str)
+			}
+
+			got, err := semver.Parse(str)
+			if !errors.Is(err, semver.ErrOverflow) {
+				t.Fatalf("Parse(%q) did not return ErrOverflow, got %v", str, err)
+			}
+
+			want := semver.Version{}
+			if got != want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, want)
+			}
+		})
+	}
+}
+
+func TestParseMaxUint(t *testing.T) {
+	str := fmt.Sprintf("%d.%d.%d", uint(math.MaxUint), uint(math.MaxUint), uint(math.MaxUint))
+	got, err := semver.Parse(str)
+	if err != nil {
+		t.Fatalf("Parse(%q) returned an error: %v", str, err)
+	}
+
+	want := semver.Version{Major: math.MaxUint, Minor: math.MaxUint, Patch: math.MaxUint}
+	if got != want {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, want)
+	}
+}
+
 func TestVersionString(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -317,6 +432,270 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestValidate(t *testing.T) {
+	for str, version := range valid {
+		t.Run(str, func(t *testing.T) {
+			if err := version.Validate(); err != nil {
+				t.Errorf("Validate returned an error for a valid version: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		component string
+		reason    string
+		version   semver.Version
+		offset    int
+	}{
+		{
+			name:      "prerelease leading zero",
+			version:   semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.01"},
+			component: "prerelease",
+			reason:    "leading zero in numeric identifier",
+			offset:    9,
+		},
+		{
+			name:      "prerelease empty identifier",
+			version:   semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc..1", Build: "b"},
+			component: "prerelease",
+			reason:    "empty identifier",
+			offset:    9,
+		},
+		{
+			name:      "prerelease illegal character",
+			version:   semver.Version{Major: 10, Minor: 20, Patch: 30, Prerelease: "rc_1"},
+			component: "prerelease",
+			reason:    "invalid character",
+			offset:    11,
+		},
+		{
+			name:      "prerelease containing build",
+			version:   semver.Version{Major: 1, Prerelease: "rc+b"},
+			component: "prerelease",
+			reason:    "invalid character",
+			offset:    8,
+		},
+		{
+			name:      "build empty identifier",
+			version:   semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "b."},
+			component: "build",
+			reason:    "empty identifier",
+			offset:    13,
+		},
+		{
+			name:      "build illegal character",
+			version:   semver.Version{Major: 1, Minor: 2, Patch: 3, Build: "sha/123"},
+			component: "build",
+			reason:    "invalid character",
+			offset:    9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.version.Validate()
+			if !errors.Is(err, semver.ErrInvalidVersion) {
+				t.Fatalf("Validate did not return ErrInvalidVersion, got %v", err)
+			}
+
+			var parseErr *semver.ParseError
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("Validate did not return a *ParseError, got %T", err)
+			}
+
+			want := &semver.ParseError{
+				Err:       semver.ErrInvalidVersion,
+				Input:     tt.version.String(),
+				Component: tt.component,
+				Reason:    tt.reason,
+				Offset:    tt.offset,
+			}
+
+			if *parseErr != *want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", parseErr, want)
+			}
+		})
+	}
+}
+
+func TestNewVersion(t *testing.T) {
+	got, err := semver.NewVersion(1, 2, 3, "rc.1", "build.5")
+	if err != nil {
+		t.Fatalf("NewVersion returned an error: %v", err)
+	}
+
+	want := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	if got != want {
+		t.Errorf("got %#v, wanted %#v", got, want)
+	}
+
+	if got.String() != "1.2.3-rc.1+build.5" {
+		t.Errorf("got %q, wanted %q", got.String(), "1.2.3-rc.1+build.5")
+	}
+
+	for _, args := range [][2]string{{"rc.01", ""}, {"", "b..1"}, {"rc+1", ""}, {"rc", "b_1"}} {
+		if _, err := semver.NewVersion(1, 2, 3, args[0], args[1]); !errors.Is(err, semver.ErrInvalidVersion) {
+			t.Errorf("NewVersion(1, 2, 3, %q, %q) did not return ErrInvalidVersion, got %v", args[0], args[1], err)
+		}
+	}
+}
+
+// FuzzValidate ensures Validate agrees with Parse, i.e. that a Version is
+// valid exactly when its String form parses back to the same Version.
+func FuzzValidate(f *testing.F) {
+	f.Add("rc.1", "build.5")
+	f.Add("rc.01", "")
+	f.Add("", "b..1")
+	f.Add("rc+1", "")
+	f.Add("a-b", "0.0")
+
+	f.Fuzz(func(t *testing.T, prerelease, build string) {
+		v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: prerelease, Build: build}
+
+		parsed, err := semver.Parse(v.String())
+		roundTrips := err == nil && parsed == v
+
+		if validateErr := v.Validate(); (validateErr == nil) != roundTrips {
+			t.Fatalf("Validate (%v) and Parse (%v, round trips: %v) disagree for %#v", validateErr, err, roundTrips, v)
+		}
+	})
+}
+
+func TestRelease(t *testing.T) {
+	tests := []struct {
+		current semver.Version
+		want    semver.Version
+	}{
+		{current: semver.Version{}, want: semver.Version{}},
+		{current: semver.Version{Major: 1, Minor: 2, Patch: 3}, want: semver.Version{Major: 1, Minor: 2, Patch: 3}},
+		{current: semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}, want: semver.Version{Major: 1, Minor: 2, Patch: 3}},
+		{current: semver.Version{Major: 1, Minor: 2, Patch: 3, Build: "build.5"}, want: semver.Version{Major: 1, Minor: 2, Patch: 3}},
+		{
+			current: semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"},
+			want:    semver.Version{Major: 1, Minor: 2, Patch: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.current.String(), func(t *testing.T) {
+			if got := tt.current.Release(); got != tt.want {
+				t.Errorf("got %#v, wanted %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithPrerelease(t *testing.T) {
+	current := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha", Build: "build.5"}
+
+	tests := []struct {
+		prerelease string
+		reason     string
+		offset     int
+		wantErr    bool
+	}{
+		{prerelease: "rc.1"},
+		{prerelease: ""},
+		{prerelease: "x-y.0.alpha"},
+		{prerelease: "rc.01", wantErr: true, reason: "leading zero in numeric identifier", offset: 3},
+		{prerelease: "rc..1", wantErr: true, reason: "empty identifier", offset: 3},
+		{prerelease: "rc.", wantErr: true, reason: "empty identifier", offset: 3},
+		{prerelease: "rc_1", wantErr: true, reason: "invalid character", offset: 2},
+		{prerelease: "rc+build", wantErr: true, reason: "invalid character", offset: 2},
+		{prerelease: "-rc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prerelease, func(t *testing.T) {
+			got, err := current.WithPrerelease(tt.prerelease)
+			if tt.wantErr {
+				var parseErr *semver.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("WithPrerelease(%q) did not return a *ParseError, got %v", tt.prerelease, err)
+				}
+
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Errorf("WithPrerelease(%q) did not wrap ErrInvalidVersion", tt.prerelease)
+				}
+
+				if parseErr.Component != "prerelease" || parseErr.Reason != tt.reason || parseErr.Offset != tt.offset {
+					t.Errorf("wrong error: got %v", parseErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("WithPrerelease(%q) returned an error: %v", tt.prerelease, err)
+			}
+
+			want := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: tt.prerelease, Build: "build.5"}
+			if got != want {
+				t.Errorf("got %#v, wanted %#v", got, want)
+			}
+
+			if !semver.IsValid(got.String()) {
+				t.Errorf("WithPrerelease produced an invalid version %q", got)
+			}
+		})
+	}
+}
+
+func TestWithBuild(t *testing.T) {
+	current := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha", Build: "build.5"}
+
+	tests := []struct {
+		build   string
+		reason  string
+		offset  int
+		wantErr bool
+	}{
+		{build: "sha.5114f85"},
+		{build: ""},
+		{build: "001.0"}, // Leading zeroes are fine in build metadata
+		{build: "b..1", wantErr: true, reason: "empty identifier", offset: 2},
+		{build: "b.", wantErr: true, reason: "empty identifier", offset: 2},
+		{build: "b_1", wantErr: true, reason: "invalid character", offset: 1},
+		{build: "b+1", wantErr: true, reason: "invalid character", offset: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.build, func(t *testing.T) {
+			got, err := current.WithBuild(tt.build)
+			if tt.wantErr {
+				var parseErr *semver.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("WithBuild(%q) did not return a *ParseError, got %v", tt.build, err)
+				}
+
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Errorf("WithBuild(%q) did not wrap ErrInvalidVersion", tt.build)
+				}
+
+				if parseErr.Component != "build" || parseErr.Reason != tt.reason || parseErr.Offset != tt.offset {
+					t.Errorf("wrong error: got %v", parseErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("WithBuild(%q) returned an error: %v", tt.build, err)
+			}
+
+			want := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha", Build: tt.build}
+			if got != want {
+				t.Errorf("got %#v, wanted %#v", got, want)
+			}
+
+			if !semver.IsValid(got.String()) {
+				t.Errorf("WithBuild produced an invalid version %q", got)
+			}
+		})
+	}
+}
+
 func TestBumpMajor(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -348,6 +727,21 @@ func TestBumpMajor(t *testing.T) {
 			current: semver.Version{Major: 123, Minor: 32, Patch: 6, Prerelease: "rc.1", Build: "build.123"},
 			want:    semver.Version{Major: 124},
 		},
+		{
+			name:    "finalise major prerelease",
+			current: semver.Version{Major: 2, Prerelease: "rc.1", Build: "build.123"},
+			want:    semver.Version{Major: 2},
+		},
+		{
+			name:    "minor prerelease",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "beta"},
+			want:    semver.Version{Major: 2},
+		},
+		{
+			name:    "patch prerelease",
+			current: semver.Version{Major: 1, Patch: 1, Prerelease: "beta"},
+			want:    semver.Version{Major: 2},
+		},
 	}
 
 	for _, tt := range tests {
@@ -390,6 +784,21 @@ func TestBumpMinor(t *testing.T) {
 			current: semver.Version{Major: 123, Minor: 32, Patch: 6, Prerelease: "rc.1", Build: "build.123"},
 			want:    semver.Version{Major: 123, Minor: 33},
 		},
+		{
+			name:    "finalise minor prerelease",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "beta", Build: "build.123"},
+			want:    semver.Version{Major: 1, Minor: 3},
+		},
+		{
+			name:    "finalise major prerelease",
+			current: semver.Version{Major: 2, Prerelease: "rc.1"},
+			want:    semver.Version{Major: 2},
+		},
+		{
+			name:    "patch prerelease",
+			current: semver.Version{Major: 1, Minor: 3, Patch: 1, Prerelease: "beta"},
+			want:    semver.Version{Major: 1, Minor: 4},
+		},
 	}
 
 	for _, tt := range tests {
@@ -424,14 +833,24 @@ func TestBumpPatch(t *testing.T) {
 		},
 		{
 			name:    "everything",
-			current: semver.Version{Major: 0, Minor: 32, Patch: 6, Prerelease: "rc.1", Build: "build.123"},
+			current: semver.Version{Major: 0, Minor: 32, Patch: 6, Build: "build.123"},
 			want:    semver.Version{Minor: 32, Patch: 7},
 		},
 		{
 			name:    "big numbers",
-			current: semver.Version{Major: 123, Minor: 32, Patch: 6, Prerelease: "rc.1", Build: "build.123"},
+			current: semver.Version{Major: 123, Minor: 32, Patch: 6, Build: "build.123"},
 			want:    semver.Version{Major: 123, Minor: 32, Patch: 7},
 		},
+		{
+			name:    "finalise prerelease",
+			current: semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.123"},
+			want:    semver.Version{Major: 1, Minor: 2, Patch: 3},
+		},
+		{
+			name:    "finalise minor prerelease",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "beta"},
+			want:    semver.Version{Major: 1, Minor: 3},
+		},
 	}
 
 	for _, tt := range tests {
@@ -443,6 +862,175 @@ func TestBumpPatch(t *testing.T) {
 	}
 }
 
+func TestBumpPrerelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		label   string
+		current semver.Version
+		want    semver.Version
+	}{
+		{
+			name:    "release starts a series",
+			current: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "rc.0"},
+		},
+		{
+			name:    "release no label",
+			current: semver.Version{Major: 1, Minor: 2, Patch: 3},
+			label:   "",
+			want:    semver.Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "0"},
+		},
+		{
+			name:    "same label",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "rc.1"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "rc.2"},
+		},
+		{
+			name:    "same label carry",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "rc.9"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "rc.10"},
+		},
+		{
+			name:    "same label no number",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "rc"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "rc.0"},
+		},
+		{
+			name:    "different label",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "alpha.3"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "rc.0"},
+		},
+		{
+			name:    "label is a prefix of an identifier",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "rcx.3"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "rc.0"},
+		},
+		{
+			name:    "dotted label",
+			current: semver.Version{Major: 1, Minor: 3, Prerelease: "beta.ios.4"},
+			label:   "beta.ios",
+			want:    semver.Version{Major: 1, Minor: 3, Prerelease: "beta.ios.5"},
+		},
+		{
+			name:    "last numeric identifier",
+			current: semver.Version{Major: 1, Prerelease: "alpha.1.beta"},
+			label:   "",
+			want:    semver.Version{Major: 1, Prerelease: "alpha.2.beta"},
+		},
+		{
+			name:    "no label no number",
+			current: semver.Version{Major: 1, Prerelease: "alpha"},
+			label:   "",
+			want:    semver.Version{Major: 1, Prerelease: "alpha.0"},
+		},
+		{
+			name:    "huge number",
+			current: semver.Version{Major: 1, Prerelease: "rc.18446744073709551615"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Prerelease: "rc.18446744073709551616"},
+		},
+		{
+			name:    "drops build",
+			current: semver.Version{Major: 1, Prerelease: "rc.1", Build: "build.123"},
+			label:   "rc",
+			want:    semver.Version{Major: 1, Prerelease: "rc.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := semver.BumpPrerelease(tt.current, tt.label)
+			if err != nil {
+				t.Fatalf("BumpPrerelease returned an error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %#v, wanted %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBumpPre(t *testing.T) {
+	current := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha.1", Build: "build.123"}
+
+	tests := []struct {
+		bump  func(semver.Version, string) (semver.Version, error)
+		name  string
+		label string
+		want  semver.Version
+	}{
+		{
+			name:  "premajor",
+			bump:  semver.BumpPremajor,
+			label: "rc",
+			want:  semver.Version{Major: 2, Prerelease: "rc.0"},
+		},
+		{
+			name:  "premajor no label",
+			bump:  semver.BumpPremajor,
+			label: "",
+			want:  semver.Version{Major: 2, Prerelease: "0"},
+		},
+		{
+			name:  "preminor",
+			bump:  semver.BumpPreminor,
+			label: "rc",
+			want:  semver.Version{Major: 1, Minor: 3, Prerelease: "rc.0"},
+		},
+		{
+			name:  "prepatch",
+			bump:  semver.BumpPrepatch,
+			label: "beta",
+			want:  semver.Version{Major: 1, Minor: 2, Patch: 4, Prerelease: "beta.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.bump(current, tt.label)
+			if err != nil {
+				t.Fatalf("bump returned an error: %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %#v, wanted %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBumpPrereleaseInvalidLabel(t *testing.T) {
+	bumps := map[string]func(semver.Version, string) (semver.Version, error){
+		"prerelease": semver.BumpPrerelease,
+		"premajor":   semver.BumpPremajor,
+		"preminor":   semver.BumpPreminor,
+		"prepatch":   semver.BumpPrepatch,
+	}
+
+	for name, bump := range bumps {
+		for _, label := range []string{"rc_1", "rc..1", "rc.", ".rc", "rc.01", "rc+build"} {
+			t.Run(name+"/"+label, func(t *testing.T) {
+				_, err := bump(semver.Version{Major: 1}, label)
+				if !errors.Is(err, semver.ErrInvalidVersion) {
+					t.Fatalf("%s with label %q did not return ErrInvalidVersion, got %v", name, label, err)
+				}
+
+				var parseErr *semver.ParseError
+				if !errors.As(err, &parseErr) {
+					t.Fatalf("%s with label %q did not return a *ParseError, got %T", name, label, err)
+				}
+			})
+		}
+	}
+}
+
 func TestIsValidYes(t *testing.T) {
 	for str := range valid {
 		t.Run(str, func(t *testing.T) {
@@ -515,6 +1103,103 @@ func FuzzParseRoundTrip(f *testing.F) {
 	})
 }
 
+// FuzzParseFaithful fuzzes Parse to ensure that no valid input is ever silently
+// mapped to a different version, either Parse reproduces it exactly or it reports
+// an overflow, in which case ParseBig must reproduce it exactly.
+func FuzzParseFaithful(f *testing.F) {
+	for str := range valid {
+		f.Add(str)
+	}
+	for _, str := range invalid {
+		f.Add(str)
+	}
+	f.Add("99999999999999999999.0.0")
+	f.Add("18446744073709551615.18446744073709551616.0-rc.1")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !semver.IsValid(s) {
+			return
+		}
+
+		want := strings.TrimPrefix(s, "v")
+
+		v, err := semver.Parse(s)
+		if err != nil {
+			if !errors.Is(err, semver.ErrOverflow) {
+				t.Fatalf("Parse(%q) returned a non overflow error for a valid version: %v", s, err)
+			}
+
+			big, err := semver.ParseBig(s)
+			if err != nil {
+				t.Fatalf("ParseBig(%q) returned an error for a valid version: %v", s, err)
+			}
+
+			if got := big.String(); got != want {
+				t.Fatalf("ParseBig(%q).String() = %q, wanted %q", s, got, want)
+			}
+			return
+		}
+
+		if got := v.String(); got != want {
+			t.Fatalf("Parse(%q).String() = %q, wanted %q", s, got, want)
+		}
+	})
+}
+
+// FuzzParseMatchesRegex fuzzes the parser against the spec's suggested regex to ensure
+// they agree on what is and isn't valid, and on the components of valid versions.
+//
+// The regex places no limit on the size of the major, minor and patch versions, so
+// where Parse reports an overflow the components are checked against ParseBig instead.
+func FuzzParseMatchesRegex(f *testing.F) {
+	for str := range valid {
+		f.Add(str)
+	}
+	for _, str := range invalid {
+		f.Add(str)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		parts := semVerRegex.FindStringSubmatch(s)
+		got, err := semver.Parse(s)
+
+		if parts != nil && errors.Is(err, semver.ErrOverflow) {
+			big, bigErr := semver.ParseBig(s)
+			if bigErr != nil {
+				t.Fatalf("ParseBig(%q) returned an error for a version the regex accepts: %v", s, bigErr)
+			}
+			got, err = semver.Version{Prerelease: big.Prerelease, Build: big.Build}, nil
+		}
+
+		if (parts != nil) != (err == nil) {
+			t.Fatalf("Parse(%q) and the regex disagree on validity, Parse error: %v", s, err)
+		}
+
+		if parts == nil {
+			return
+		}
+
+		pre := parts[semVerRegex.SubexpIndex("pre")]
+		build := parts[semVerRegex.SubexpIndex("build")]
+		if got.Prerelease != pre || got.Build != build {
+			t.Fatalf("Parse(%q) = %#v, regex got pre: %q, build: %q", s, got, pre, build)
+		}
+	})
+}
+
+func TestParseAllocations(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		_, err := semver.Parse("v12.4.3-rc1+build.123")
+		if err != nil {
+			t.Fatalf("Parse returned an error: %v", err)
+		}
+	})
+
+	if allocs != 0 {
+		t.Errorf("Parse allocated %v times per run, wanted 0", allocs)
+	}
+}
+
 func BenchmarkVersionParse(b *testing.B) {
 	for b.Loop() {
 		_, err := semver.Parse("v12.4.3-rc1+build.123")
@@ -561,6 +1246,36 @@ func ExampleParse() {
 	// Output: 1.19.0
 }
 
+func ExampleParseStrict() {
+	_, err := semver.ParseStrict("v1.19.0")
+	fmt.Println(err)
+
+	v, err := semver.ParseStrict("1.19.0")
+	if err != nil {
+		fmt.Println(err)
+	}
+	fmt.Println(v)
+	// Output:
+	// parse "v1.19.0": leading 'v' not allowed in major at offset 0
+	// 1.19.0
+}
+
+func ExampleNewVersion() {
+	v, err := semver.NewVersion(1, 2, 3, "rc.1", "build.5")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(v)
+
+	_, err = semver.NewVersion(1, 2, 3, "rc.01", "")
+	fmt.Println(err)
+
+	// Output:
+	// 1.2.3-rc.1+build.5
+	// parse "1.2.3-rc.01": leading zero in numeric identifier in prerelease at offset 9
+}
+
 func ExampleBumpMajor() {
 	current, err := semver.Parse("3.12.0")
 	if err != nil {
@@ -591,6 +1306,52 @@ func ExampleBumpPatch() {
 	// Output: 3.12.1
 }
 
+func ExampleBumpPrerelease() {
+	current, err := semver.Parse("1.3.0-rc.1")
+	if err != nil {
+		fmt.Println("could not parse")
+	}
+
+	next, err := semver.BumpPrerelease(current, "rc")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(next)
+
+	// A release starts a new series on the next patch
+	next, err = semver.BumpPrerelease(semver.Version{Major: 1, Minor: 2, Patch: 3}, "rc")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(next)
+
+	// Output:
+	// 1.3.0-rc.2
+	// 1.2.4-rc.0
+}
+
+func ExampleVersion_WithPrerelease() {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3}
+
+	rc, err := v.WithPrerelease("rc.1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(rc)
+	fmt.Println(rc.Release())
+
+	_, err = v.WithPrerelease("rc.01")
+	fmt.Println(err)
+
+	// Output:
+	// 1.2.3-rc.1
+	// 1.2.3
+	// parse "rc.01": leading zero in numeric identifier in prerelease at offset 3
+}
+
 func ExampleIsValid() {
 	// Don't need the 'v' at the start
 	one := semver.IsValid("1.19.0")
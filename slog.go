@@ -0,0 +1,36 @@
+package semver
+
+import "log/slog"
+
+// LogValue implements [slog.LogValuer] for a Version, logging it as a group
+// of its components so that log pipelines may filter on them without having
+// to parse the version again.
+//
+//	slog.Info("released", "version", Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"})
+//	// level=INFO msg=released version.major=1 version.minor=2 version.patch=3 version.prerelease=rc.1 version.build=""
+//
+// To log the canonical string instead, wrap the version in a [LogString].
+func (v Version) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Uint64(major, uint64(v.Major)),
+		slog.Uint64(minor, uint64(v.Minor)),
+		slog.Uint64(patch, uint64(v.Patch)),
+		slog.String(pre, v.Prerelease),
+		slog.String(build, v.Build),
+	)
+}
+
+// LogString is a [Version] that is logged as its canonical string form,
+// rather than as the group of its components.
+//
+// Unlike logging v.String() directly, the string is only built if the
+// log record is actually handled.
+//
+//	slog.Info("released", "version", LogString(v))
+//	// level=INFO msg=released version=1.2.3-rc.1
+type LogString Version
+
+// LogValue implements [slog.LogValuer] for a LogString.
+func (s LogString) LogValue() slog.Value {
+	return slog.StringValue(Version(s).String())
+}
@@ -0,0 +1,90 @@
+package semver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var (
+	_ slog.LogValuer = semver.Version{}
+	_ slog.LogValuer = semver.LogString{}
+)
+
+func TestLogValue(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	logger.Info("released", "version", v)
+
+	var record struct {
+		Version struct {
+			Prerelease string `json:"prerelease"`
+			Build      string `json:"build"`
+			Major      uint   `json:"major"`
+			Minor      uint   `json:"minor"`
+			Patch      uint   `json:"patch"`
+		} `json:"version"`
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("could not decode log record %s: %v", buf, err)
+	}
+
+	got := semver.Version{
+		Prerelease: record.Version.Prerelease,
+		Build:      record.Version.Build,
+		Major:      record.Version.Major,
+		Minor:      record.Version.Minor,
+		Patch:      record.Version.Patch,
+	}
+
+	if got != v {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, v)
+	}
+}
+
+func TestLogString(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewJSONHandler(buf, nil))
+
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1", Build: "build.5"}
+	logger.Info("released", "version", semver.LogString(v))
+
+	var record struct {
+		Version string `json:"version"`
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("could not decode log record %s: %v", buf, err)
+	}
+
+	if record.Version != v.String() {
+		t.Errorf("got %q, wanted %q", record.Version, v.String())
+	}
+}
+
+func ExampleVersion_LogValue() {
+	// Drop the time so the output is stable
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}
+	logger.Info("released", "version", v)
+	logger.Info("released", "version", semver.LogString(v))
+
+	// Output:
+	// level=INFO msg=released version.major=1 version.minor=2 version.patch=3 version.prerelease=rc.1 version.build=""
+	// level=INFO msg=released version=1.2.3-rc.1
+}
@@ -0,0 +1,211 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// Tags used in the sort key encoding, their relative order is what makes
+// the encoding order preserving so they must not be changed.
+const (
+	keyEnd     byte = 0x00 // End of an alphanumeric identifier, or of the pre-release
+	keyNumeric byte = 0x01 // A numeric pre-release identifier follows
+	keyAlpha   byte = 0x02 // An alphanumeric pre-release identifier follows
+	keyRelease byte = 0x03 // The version has no pre-release
+)
+
+// AppendSortKey appends a binary encoding of v to b whose byte order is exactly
+// semver precedence, that is for any two valid versions a and b:
+//
+//	bytes.Compare(a.AppendSortKey(nil), b.AppendSortKey(nil)) == Compare(a, b)
+//
+// This makes it suitable for use as a key in ordered key value stores, where range
+// scans over keys then become range scans over versions. For example, every 1.x
+// version (including pre-releases) lies in the half open key range
+// [Version{Major: 1, Prerelease: "0"}, Version{Major: 2, Prerelease: "0"}).
+//
+// Because build metadata does not contribute to precedence it is not encoded,
+// so versions differing only in build metadata have identical keys. The encoding
+// is decoded with [ParseSortKey].
+//
+// The layout is as follows:
+//
+//   - The major, minor and patch versions, each as a single length byte followed by
+//     that many bytes of the number in big endian order, with no leading zero bytes.
+//   - If there is no pre-release, a single 0x03 byte.
+//   - Otherwise each pre-release identifier in turn, followed by a single 0x00 byte.
+//     Numeric identifiers are a 0x01 byte then the number of digits (encoded as above)
+//     then the digits themselves, alphanumeric identifiers a 0x02 byte then the
+//     identifier then a 0x00 byte.
+func (v Version) AppendSortKey(b []byte) []byte {
+	b = appendKeyUint(b, uint64(v.Major))
+	b = appendKeyUint(b, uint64(v.Minor))
+	b = appendKeyUint(b, uint64(v.Patch))
+
+	if v.Prerelease == "" {
+		return append(b, keyRelease)
+	}
+
+	rest := v.Prerelease
+	for rest != "" {
+		var ident string
+		ident, rest, _ = strings.Cut(rest, ".")
+
+		if isNumeric(ident) {
+			// Strictly there should be no leading zeroes, but be consistent with Compare
+			// for hand crafted versions
+			digits := strings.TrimLeft(ident, "0")
+			b = append(b, keyNumeric)
+			b = appendKeyUint(b, uint64(len(digits)))
+			b = append(b, digits...)
+		} else {
+			b = append(b, keyAlpha)
+			b = append(b, ident...)
+			b = append(b, keyEnd)
+		}
+	}
+
+	return append(b, keyEnd)
+}
+
+// ParseSortKey decodes a sort key produced by [Version.AppendSortKey] back into a Version.
+//
+// As build metadata is not part of the sort key, the returned Version never has any.
+// If key is not exactly one valid sort key, an error is returned.
+func ParseSortKey(key []byte) (Version, error) {
+	var (
+		nums [3]uint
+		err  error
+	)
+	names := [...]string{major, minor, patch}
+	for i := range nums {
+		var n uint64
+		n, key, err = readKeyUint(key)
+		if err != nil {
+			return Version{}, fmt.Errorf("invalid sort key: %s: %w", names[i], err)
+		}
+		if n > math.MaxUint {
+			return Version{}, fmt.Errorf("invalid sort key: %s: %w", names[i], ErrOverflow)
+		}
+		nums[i] = uint(n)
+	}
+
+	v := Version{Major: nums[0], Minor: nums[1], Patch: nums[2]}
+
+	if len(key) == 1 && key[0] == keyRelease {
+		return v, nil
+	}
+
+	var prerelease strings.Builder
+	for {
+		if len(key) == 0 {
+			return Version{}, errors.New("invalid sort key: unterminated pre-release")
+		}
+
+		tag := key[0]
+		key = key[1:]
+
+		if tag == keyEnd {
+			break
+		}
+
+		if prerelease.Len() != 0 {
+			prerelease.WriteByte('.')
+		}
+
+		switch tag {
+		case keyNumeric:
+			var length uint64
+			length, key, err = readKeyUint(key)
+			if err != nil {
+				return Version{}, fmt.Errorf("invalid sort key: pre-release: %w", err)
+			}
+			if length == 0 {
+				// Zero is encoded as no digits
+				prerelease.WriteByte('0')
+				continue
+			}
+			if length > uint64(len(key)) {
+				return Version{}, errors.New("invalid sort key: pre-release: truncated numeric identifier")
+			}
+			digits := key[:length]
+			if !isNumeric(string(digits)) || digits[0] == '0' {
+				return Version{}, fmt.Errorf("invalid sort key: pre-release: invalid numeric identifier %q", digits)
+			}
+			prerelease.Write(digits)
+			key = key[length:]
+		case keyAlpha:
+			end := 0
+			for end < len(key) && key[end] != keyEnd {
+				end++
+			}
+			if end == len(key) {
+				return Version{}, errors.New("invalid sort key: pre-release: unterminated identifier")
+			}
+			ident := string(key[:end])
+			if checkIdentifier(ident, true) != "" || isNumeric(ident) || strings.IndexFunc(ident, isNotIdentifierRune) != -1 {
+				return Version{}, fmt.Errorf("invalid sort key: pre-release: invalid identifier %q", ident)
+			}
+			prerelease.WriteString(ident)
+			key = key[end+1:]
+		default:
+			return Version{}, fmt.Errorf("invalid sort key: pre-release: unexpected byte 0x%02x", tag)
+		}
+	}
+
+	if len(key) != 0 {
+		return Version{}, fmt.Errorf("invalid sort key: %d trailing bytes", len(key))
+	}
+
+	if prerelease.Len() == 0 {
+		return Version{}, errors.New("invalid sort key: empty pre-release")
+	}
+
+	v.Prerelease = prerelease.String()
+	return v, nil
+}
+
+// appendKeyUint appends the order preserving encoding of n to b, a single
+// byte length followed by the minimal big endian bytes of n.
+func appendKeyUint(b []byte, n uint64) []byte {
+	length := (bits.Len64(n) + 7) / 8
+	b = append(b, byte(length))
+	for i := length - 1; i >= 0; i-- {
+		b = append(b, byte(n>>(8*i)))
+	}
+	return b
+}
+
+// readKeyUint reads a number encoded by appendKeyUint from the front of b,
+// returning it and the rest of b.
+func readKeyUint(b []byte) (n uint64, rest []byte, err error) {
+	if len(b) == 0 {
+		return 0, nil, errors.New("unexpected end of key")
+	}
+
+	length := int(b[0])
+	b = b[1:]
+
+	switch {
+	case length > 8:
+		return 0, nil, ErrOverflow
+	case length > len(b):
+		return 0, nil, errors.New("unexpected end of key")
+	case length > 0 && b[0] == 0:
+		return 0, nil, errors.New("non-canonical number")
+	}
+
+	for _, byt := range b[:length] {
+		n = n<<8 | uint64(byt)
+	}
+
+	return n, b[length:], nil
+}
+
+// isNotIdentifierRune reports whether r may not appear in a pre-release or build identifier.
+func isNotIdentifierRune(r rune) bool {
+	return r >= 0x80 || !isIdentifierChar(byte(r))
+}
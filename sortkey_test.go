@@ -0,0 +1,246 @@
+package semver_test
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+func TestSortKeyOrder(t *testing.T) {
+	// In strictly increasing order of precedence
+	ordered := []string{
+		"0.0.0-0",
+		"0.0.0",
+		"0.0.1",
+		"0.1.0",
+		"0.255.0",
+		"0.256.0",
+		"1.0.0-0",
+		"1.0.0-1",
+		"1.0.0-9",
+		"1.0.0-10",
+		"1.0.0-255",
+		"1.0.0-256",
+		"1.0.0-99999999999999999999999",
+		"1.0.0--",
+		"1.0.0-A",
+		"1.0.0-Z",
+		"1.0.0-a",
+		"1.0.0-alpha",
+		"1.0.0-alpha.0",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.1.0",
+		"1.0.0-alpha.1.a",
+		"1.0.0-alpha.beta",
+		"1.0.0-alpha0",
+		"1.0.0-alpha0.1",
+		"1.0.0-alphaa",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"2.0.0",
+		"18446744073709551615.0.0",
+	}
+
+	var previous []byte
+	for _, str := range ordered {
+		v, err := semver.Parse(str)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned an error: %v", str, err)
+		}
+
+		key := v.AppendSortKey(nil)
+		if previous != nil && bytes.Compare(previous, key) >= 0 {
+			t.Errorf("key for %s (%x) does not sort after the previous key (%x)", v, key, previous)
+		}
+		previous = key
+	}
+}
+
+func TestSortKeyRoundTrip(t *testing.T) {
+	for str, want := range valid {
+		t.Run(str, func(t *testing.T) {
+			key := want.AppendSortKey(nil)
+
+			got, err := semver.ParseSortKey(key)
+			if err != nil {
+				t.Fatalf("ParseSortKey(%x) returned an error: %v", key, err)
+			}
+
+			// Build metadata is not part of the key
+			want.Build = ""
+			if got != want {
+				t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", got, want)
+			}
+		})
+	}
+}
+
+func TestSortKeyBuildIgnored(t *testing.T) {
+	a := semver.Version{Major: 1, Minor: 2, Patch: 3, Build: "abc"}
+	b := semver.Version{Major: 1, Minor: 2, Patch: 3, Build: "def"}
+
+	if !bytes.Equal(a.AppendSortKey(nil), b.AppendSortKey(nil)) {
+		t.Error("versions differing only in build metadata should have equal keys")
+	}
+}
+
+func TestSortKeyAppends(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3}
+	prefix := []byte("releases/")
+
+	got := v.AppendSortKey(prefix)
+	if !bytes.HasPrefix(got, prefix) {
+		t.Fatalf("AppendSortKey did not append, got %x", got)
+	}
+
+	decoded, err := semver.ParseSortKey(got[len(prefix):])
+	if err != nil {
+		t.Fatalf("ParseSortKey returned an error: %v", err)
+	}
+
+	if decoded != v {
+		t.Errorf("\nGot:\t%#v\nWanted:\t%#v\n", decoded, v)
+	}
+}
+
+func TestParseSortKeyInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		key  []byte
+	}{
+		{name: "empty", key: nil},
+		{name: "truncated major", key: []byte{0x02, 0x01}},
+		{name: "missing minor", key: []byte{0x01, 0x01}},
+		{name: "overflow", key: []byte{0x09, 1, 2, 3, 4, 5, 6, 7, 8, 9, 0x00, 0x00, 0x03}},
+		{name: "non-canonical number", key: []byte{0x02, 0x00, 0x01, 0x00, 0x00, 0x03}},
+		{name: "missing release or pre-release", key: []byte{0x01, 0x01, 0x00, 0x00}},
+		{name: "trailing after release", key: []byte{0x01, 0x01, 0x00, 0x00, 0x03, 0x03}},
+		{name: "empty pre-release", key: []byte{0x01, 0x01, 0x00, 0x00, 0x00}},
+		{name: "unterminated pre-release", key: []byte{0x01, 0x01, 0x00, 0x00, 0x02, 'r', 'c', 0x00}},
+		{name: "unterminated identifier", key: []byte{0x01, 0x01, 0x00, 0x00, 0x02, 'r', 'c'}},
+		{name: "invalid identifier", key: []byte{0x01, 0x01, 0x00, 0x00, 0x02, 'r', '_', 0x00, 0x00}},
+		{name: "numeric as alphanumeric", key: []byte{0x01, 0x01, 0x00, 0x00, 0x02, '1', 0x00, 0x00}},
+		{name: "truncated numeric", key: []byte{0x01, 0x01, 0x00, 0x00, 0x01, 0x01, 0x03}},
+		{name: "leading zero numeric", key: []byte{0x01, 0x01, 0x00, 0x00, 0x01, 0x01, 0x02, '0', '1', 0x00}},
+		{name: "non-digit numeric", key: []byte{0x01, 0x01, 0x00, 0x00, 0x01, 0x01, 'a', 0x00}},
+		{name: "unknown tag", key: []byte{0x01, 0x01, 0x00, 0x00, 0x07, 0x00}},
+		{name: "trailing after pre-release", key: []byte{0x01, 0x01, 0x00, 0x00, 0x02, 'r', 'c', 0x00, 0x00, 0xff}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if v, err := semver.ParseSortKey(tt.key); err == nil {
+				t.Errorf("ParseSortKey(%x) did not return an error, got %#v", tt.key, v)
+			}
+		})
+	}
+}
+
+// FuzzSortKeyOrder is a property test asserting that the byte order of sort keys
+// is exactly semver precedence, and that every key decodes back to its version.
+func FuzzSortKeyOrder(f *testing.F) {
+	var all []string
+	for str := range valid {
+		all = append(all, str)
+	}
+	slices.Sort(all)
+	for i := range all {
+		f.Add(all[i], all[(i+1)%len(all)])
+	}
+	f.Add("1.0.0-alpha.1", "1.0.0-alpha.beta")
+	f.Add("1.0.0-alpha", "1.0.0-alpha.0")
+	f.Add("1.0.0-rc.99", "1.0.0-rc.100")
+
+	f.Fuzz(func(t *testing.T, x, y string) {
+		a, err := semver.Parse(x)
+		if err != nil {
+			return
+		}
+		b, err := semver.Parse(y)
+		if err != nil {
+			return
+		}
+
+		keyA, keyB := a.AppendSortKey(nil), b.AppendSortKey(nil)
+
+		if got, want := bytes.Compare(keyA, keyB), semver.Compare(a, b); got != want {
+			t.Fatalf("bytes.Compare(key(%s), key(%s)) = %d, Compare = %d", a, b, got, want)
+		}
+
+		decoded, err := semver.ParseSortKey(keyA)
+		if err != nil {
+			t.Fatalf("ParseSortKey(key(%s)) returned an error: %v", a, err)
+		}
+
+		a.Build = ""
+		if decoded != a {
+			t.Fatalf("\nDecoded:\t%#v\nOriginal:\t%#v\n", decoded, a)
+		}
+	})
+}
+
+// FuzzParseSortKey ensures ParseSortKey never panics on arbitrary input, and that
+// anything it accepts re-encodes to exactly the same key.
+func FuzzParseSortKey(f *testing.F) {
+	for _, version := range valid {
+		f.Add(version.AppendSortKey(nil))
+	}
+
+	f.Fuzz(func(t *testing.T, key []byte) {
+		v, err := semver.ParseSortKey(key)
+		if err != nil {
+			return
+		}
+
+		if got := v.AppendSortKey(nil); !bytes.Equal(got, key) {
+			t.Fatalf("ParseSortKey(%x) = %#v which re-encodes to %x", key, v, got)
+		}
+	})
+}
+
+func BenchmarkAppendSortKey(b *testing.B) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "alpha.beta.12"}
+	buf := make([]byte, 0, 64)
+
+	for b.Loop() {
+		buf = v.AppendSortKey(buf[:0])
+	}
+}
+
+func ExampleVersion_AppendSortKey() {
+	versions := []semver.Version{
+		{Major: 1, Minor: 10, Patch: 0},
+		{Major: 2, Minor: 0, Patch: 0, Prerelease: "rc.1"},
+		{Major: 1, Minor: 2, Patch: 0, Prerelease: "beta"},
+		{Major: 1, Minor: 2, Patch: 0},
+		{Major: 0, Minor: 9, Patch: 0},
+	}
+
+	// Sorting by key, as an ordered key value store would
+	slices.SortFunc(versions, func(a, b semver.Version) int {
+		return bytes.Compare(a.AppendSortKey(nil), b.AppendSortKey(nil))
+	})
+
+	// Every 1.x version lies between these two keys
+	lower := semver.Version{Major: 1, Prerelease: "0"}.AppendSortKey(nil)
+	upper := semver.Version{Major: 2, Prerelease: "0"}.AppendSortKey(nil)
+
+	for _, version := range versions {
+		key := version.AppendSortKey(nil)
+		inRange := bytes.Compare(key, lower) >= 0 && bytes.Compare(key, upper) < 0
+		fmt.Printf("%s\t1.x: %v\n", version, inRange)
+	}
+	// Output:
+	// 0.9.0	1.x: false
+	// 1.2.0-beta	1.x: true
+	// 1.2.0	1.x: true
+	// 1.10.0	1.x: true
+	// 2.0.0-rc.1	1.x: false
+}
@@ -0,0 +1,64 @@
+package semver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Scan implements [database/sql.Scanner] for a Version, allowing it to be
+// read directly from a database column holding its string form.
+//
+// src may be a string or a []byte, anything else (including NULL) is an error,
+// use [NullVersion] for nullable columns.
+func (v *Version) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	case nil:
+		return errors.New("cannot scan NULL into a semver.Version, use semver.NullVersion")
+	default:
+		return fmt.Errorf("cannot scan %T into a semver.Version", src)
+	}
+}
+
+// Value implements [database/sql/driver.Valuer] for a Version, storing it
+// as its canonical string form.
+func (v Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
+
+// NullVersion represents a [Version] that may be NULL, in the same way
+// as [database/sql.NullString].
+//
+// It implements [database/sql.Scanner] and [database/sql/driver.Valuer] so
+// it can be used as a scan destination and a query argument.
+type NullVersion struct {
+	Version Version // The version, only meaningful if Valid is true
+	Valid   bool    // Valid is true if Version is not NULL
+}
+
+// Scan implements [database/sql.Scanner] for a NullVersion.
+func (n *NullVersion) Scan(src any) error {
+	if src == nil {
+		*n = NullVersion{}
+		return nil
+	}
+
+	if err := n.Version.Scan(src); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// Value implements [database/sql/driver.Valuer] for a NullVersion.
+func (n NullVersion) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Version.Value()
+}
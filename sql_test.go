@@ -0,0 +1,280 @@
+package semver_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+var (
+	_ sql.Scanner   = (*semver.Version)(nil)
+	_ driver.Valuer = semver.Version{}
+	_ sql.Scanner   = (*semver.NullVersion)(nil)
+	_ driver.Valuer = semver.NullVersion{}
+)
+
+func init() {
+	sql.Register("semverfake", &fakeDriver{tables: make(map[string]*fakeTable)})
+}
+
+// fakeDriver is a tiny in-memory database/sql driver so the Scanner and Valuer
+// implementations can be tested end to end without a real database.
+//
+// Each DSN is a single table with a single column, it understands exactly two
+// statements: "INSERT" which appends its one argument as a row, and "SELECT"
+// which returns every row.
+type fakeDriver struct {
+	tables map[string]*fakeTable
+	mu     sync.Mutex
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	table, ok := d.tables[name]
+	if !ok {
+		table = &fakeTable{}
+		d.tables[name] = table
+	}
+
+	return &fakeConn{table: table}, nil
+}
+
+type fakeTable struct {
+	rows []driver.Value
+	mu   sync.Mutex
+}
+
+type fakeConn struct {
+	table *fakeTable
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	switch {
+	case strings.HasPrefix(query, "INSERT"), strings.HasPrefix(query, "SELECT"):
+		return &fakeStmt{table: c.table, query: query}, nil
+	default:
+		return nil, fmt.Errorf("fake driver does not understand %q", query)
+	}
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("transactions not supported") }
+
+type fakeStmt struct {
+	table *fakeTable
+	query string
+}
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int {
+	if strings.HasPrefix(s.query, "INSERT") {
+		return 1
+	}
+	return 0
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+
+	s.table.rows = append(s.table.rows, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.table.mu.Lock()
+	defer s.table.mu.Unlock()
+
+	rows := make([]driver.Value, len(s.table.rows))
+	copy(rows, s.table.rows)
+	return &fakeRows{rows: rows}, nil
+}
+
+type fakeRows struct {
+	rows []driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"version"} }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	dest[0], r.rows = r.rows[0], r.rows[1:]
+	return nil
+}
+
+// openFakeDB opens a fresh, empty fake database for the test.
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("semverfake", t.Name())
+	if err != nil {
+		t.Fatalf("could not open fake database: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestVersionSQL(t *testing.T) {
+	db := openFakeDB(t)
+
+	want := []semver.Version{
+		{Major: 1, Minor: 2, Patch: 3},
+		{Major: 2, Minor: 0, Patch: 0, Prerelease: "rc.1", Build: "build.5"},
+	}
+
+	for _, version := range want {
+		if _, err := db.Exec("INSERT", version); err != nil {
+			t.Fatalf("could not insert %s: %v", version, err)
+		}
+	}
+
+	// Scanning from []byte as well as string
+	if _, err := db.Exec("INSERT", []byte("v3.4.5-beta")); err != nil {
+		t.Fatalf("could not insert raw bytes: %v", err)
+	}
+	want = append(want, semver.Version{Major: 3, Minor: 4, Patch: 5, Prerelease: "beta"})
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("could not query: %v", err)
+	}
+	defer rows.Close()
+
+	var got []semver.Version
+	for rows.Next() {
+		var version semver.Version
+		if err := rows.Scan(&version); err != nil {
+			t.Fatalf("could not scan: %v", err)
+		}
+		got = append(got, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.Fatalf("rows.Err: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, wanted %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d\nGot:\t%#v\nWanted:\t%#v\n", i, got[i], want[i])
+		}
+	}
+}
+
+func TestVersionScanErrors(t *testing.T) {
+	tests := []struct {
+		src  any
+		name string
+	}{
+		{name: "nil", src: nil},
+		{name: "int", src: int64(1)},
+		{name: "invalid string", src: "1.02.3"},
+		{name: "invalid bytes", src: []byte("not a version")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var v semver.Version
+			if err := v.Scan(tt.src); err == nil {
+				t.Errorf("Scan(%#v) did not return an error", tt.src)
+			}
+		})
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	v := semver.Version{Major: 1, Minor: 2, Patch: 3, Prerelease: "rc.1"}
+
+	got, err := v.Value()
+	if err != nil {
+		t.Fatalf("Value returned an error: %v", err)
+	}
+
+	if got != "1.2.3-rc.1" {
+		t.Errorf("got %#v, wanted %#v", got, "1.2.3-rc.1")
+	}
+}
+
+func TestNullVersionSQL(t *testing.T) {
+	db := openFakeDB(t)
+
+	want := []semver.NullVersion{
+		{Version: semver.Version{Major: 1, Minor: 2, Patch: 3}, Valid: true},
+		{},
+		{Version: semver.Version{Major: 4, Prerelease: "alpha"}, Valid: true},
+	}
+
+	for _, version := range want {
+		if _, err := db.Exec("INSERT", version); err != nil {
+			t.Fatalf("could not insert %v: %v", version, err)
+		}
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("could not query: %v", err)
+	}
+	defer rows.Close()
+
+	var got []semver.NullVersion
+	for rows.Next() {
+		// Start non-zero to make sure NULL resets it
+		version := semver.NullVersion{Version: semver.Version{Major: 9}, Valid: true}
+		if err := rows.Scan(&version); err != nil {
+			t.Fatalf("could not scan: %v", err)
+		}
+		got = append(got, version)
+	}
+
+	if err := rows.Err(); err != nil {
+		t.Fatalf("rows.Err: %v", err)
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d rows, wanted %d", len(got), len(want))
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("row %d\nGot:\t%#v\nWanted:\t%#v\n", i, got[i], want[i])
+		}
+	}
+
+	// Scanning NULL into a plain Version is an error
+	rows, err = db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("could not query: %v", err)
+	}
+	defer rows.Close()
+
+	var scanErr error
+	for rows.Next() {
+		var version semver.Version
+		if err := rows.Scan(&version); err != nil {
+			scanErr = err
+		}
+	}
+
+	if scanErr == nil {
+		t.Error("scanning NULL into a semver.Version did not return an error")
+	}
+}
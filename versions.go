@@ -0,0 +1,137 @@
+package semver
+
+import "slices"
+
+// Versions is a collection of versions, e.g. the tags of a git repository, with
+// methods for the common operations of sorting, de-duplicating and selecting
+// the latest of them.
+//
+// It is simply a []Version so may be converted to and from one freely. Apart
+// from [Versions.Sort], no method modifies the collection it is called on.
+type Versions []Version
+
+// Sort sorts the versions in place in ascending order of precedence, see [Compare].
+//
+// The sort is stable, so versions of equal precedence (i.e. differing only in
+// their build metadata) keep their original order.
+func (vs Versions) Sort() {
+	slices.SortStableFunc(vs, Compare)
+}
+
+// Dedupe returns the versions sorted as in [Versions.Sort], keeping only one of
+// each set of versions with equal precedence, i.e. that differ only in their
+// build metadata.
+//
+// If keepLast is false the first of each set (in the original order) is kept,
+// otherwise the last.
+//
+//	vs := Versions{{Major: 1, Build: "a"}, {Major: 1, Build: "b"}}
+//	vs.Dedupe(false) // [1.0.0+a]
+//	vs.Dedupe(true)  // [1.0.0+b]
+func (vs Versions) Dedupe(keepLast bool) Versions {
+	sorted := slices.Clone(vs)
+	sorted.Sort()
+
+	if keepLast {
+		// Compact keeps the first of each run, so reverse the runs first
+		slices.Reverse(sorted)
+		sorted = slices.CompactFunc(sorted, Version.Equal)
+		slices.Reverse(sorted)
+		return sorted
+	}
+
+	return slices.CompactFunc(sorted, Version.Equal)
+}
+
+// Filter returns the versions for which keep returns true, in their original order.
+//
+// Any func(Version) bool may be used, such as [Version.IsStable] or [Constraint.Check]:
+//
+//	c, _ := ParseConstraint("1.4.x")
+//	patches := vs.Filter(c.Check)
+func (vs Versions) Filter(keep func(Version) bool) Versions {
+	var filtered Versions
+	for _, v := range vs {
+		if keep(v) {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
+// Latest returns the version with the highest precedence, ignoring pre-releases
+// unless includePrerelease is true.
+//
+// If there are several with equal precedence, the first is returned. If there are
+// no versions to choose from, ok is false.
+//
+//	vs := Versions{{Major: 1}, {Major: 2, Prerelease: "rc.1"}}
+//	latest, _ := vs.Latest(false) // 1.0.0
+func (vs Versions) Latest(includePrerelease bool) (latest Version, ok bool) {
+	for _, v := range vs {
+		if v.Prerelease != "" && !includePrerelease {
+			continue
+		}
+		if !ok || latest.Less(v) {
+			latest, ok = v, true
+		}
+	}
+	return latest, ok
+}
+
+// LatestPerMajor returns the latest version (as in [Versions.Latest]) of each major
+// version, in ascending order.
+//
+// This gives the latest release in each line for projects that support several
+// major versions at once.
+//
+//	vs := Versions{{Major: 1, Minor: 2}, {Major: 1, Minor: 9}, {Major: 2}}
+//	vs.LatestPerMajor(false) // [1.9.0 2.0.0]
+func (vs Versions) LatestPerMajor(includePrerelease bool) Versions {
+	return vs.latestPer(includePrerelease, func(a, b Version) bool {
+		return a.Major == b.Major
+	})
+}
+
+// LatestPerMinor returns the latest version (as in [Versions.Latest]) of each
+// major.minor version, in ascending order.
+//
+//	vs := Versions{{Major: 1, Minor: 2}, {Major: 1, Minor: 2, Patch: 5}, {Major: 1, Minor: 3}}
+//	vs.LatestPerMinor(false) // [1.2.5 1.3.0]
+func (vs Versions) LatestPerMinor(includePrerelease bool) Versions {
+	return vs.latestPer(includePrerelease, func(a, b Version) bool {
+		return a.Major == b.Major && a.Minor == b.Minor
+	})
+}
+
+// latestPer returns the latest version in each group of versions for which
+// same returns true, in ascending order.
+func (vs Versions) latestPer(includePrerelease bool, same func(a, b Version) bool) Versions {
+	candidates := vs
+	if !includePrerelease {
+		candidates = vs.Filter(isRelease)
+	}
+
+	sorted := slices.Clone(candidates)
+	sorted.Sort()
+
+	var latest Versions
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && same(sorted[start], sorted[end]) {
+			end++
+		}
+
+		// Pre-releases have already been filtered out if need be
+		best, _ := sorted[start:end].Latest(true)
+		latest = append(latest, best)
+		start = end
+	}
+
+	return latest
+}
+
+// isRelease reports whether v is not a pre-release.
+func isRelease(v Version) bool {
+	return v.Prerelease == ""
+}
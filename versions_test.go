@@ -0,0 +1,194 @@
+package semver_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"go.followtheprocess.codes/semver"
+)
+
+// mustParseAll parses each of texts, failing the test if any are invalid.
+func mustParseAll(tb testing.TB, texts ...string) semver.Versions {
+	tb.Helper()
+
+	vs := make(semver.Versions, 0, len(texts))
+	for _, text := range texts {
+		v, err := semver.Parse(text)
+		if err != nil {
+			tb.Fatalf("Parse(%q) returned an error: %v", text, err)
+		}
+		vs = append(vs, v)
+	}
+
+	return vs
+}
+
+// versionStrings returns the String form of each of vs.
+func versionStrings(vs semver.Versions) []string {
+	out := make([]string, 0, len(vs))
+	for _, v := range vs {
+		out = append(out, v.String())
+	}
+	return out
+}
+
+func TestVersionsSort(t *testing.T) {
+	vs := mustParseAll(t, "2.0.0", "1.0.0+b", "1.0.0-rc.1", "0.9.0", "1.0.0+a", "1.0.0", "1.10.0", "1.2.0")
+	vs.Sort()
+
+	want := []string{"0.9.0", "1.0.0-rc.1", "1.0.0+b", "1.0.0+a", "1.0.0", "1.2.0", "1.10.0", "2.0.0"}
+	if got := versionStrings(vs); !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+}
+
+func TestVersionsDedupe(t *testing.T) {
+	vs := mustParseAll(t, "1.0.0+b", "2.0.0", "1.0.0+a", "1.0.0-rc.1+x", "1.0.0", "1.0.0-rc.1+y", "2.0.0")
+	original := slices.Clone(vs)
+
+	tests := []struct {
+		name     string
+		want     []string
+		keepLast bool
+	}{
+		{name: "keep first", keepLast: false, want: []string{"1.0.0-rc.1+x", "1.0.0+b", "2.0.0"}},
+		{name: "keep last", keepLast: true, want: []string{"1.0.0-rc.1+y", "1.0.0", "2.0.0"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionStrings(vs.Dedupe(tt.keepLast)); !slices.Equal(got, tt.want) {
+				t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, tt.want)
+			}
+
+			if !slices.Equal(vs, original) {
+				t.Errorf("Dedupe modified the original: %v", vs)
+			}
+		})
+	}
+
+	if got := semver.Versions(nil).Dedupe(false); len(got) != 0 {
+		t.Errorf("Dedupe of nil returned %v", got)
+	}
+}
+
+func TestVersionsFilter(t *testing.T) {
+	vs := mustParseAll(t, "1.3.9", "1.4.0", "1.4.2-rc.1", "1.4.2", "1.5.0", "0.1.0")
+
+	c, err := semver.ParseConstraint("1.4.x")
+	if err != nil {
+		t.Fatalf("ParseConstraint returned an error: %v", err)
+	}
+
+	if got, want := versionStrings(vs.Filter(c.Check)), []string{"1.4.0", "1.4.2"}; !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+
+	if got, want := versionStrings(vs.Filter(semver.Version.IsStable)), []string{"1.3.9", "1.4.0", "1.4.2", "1.5.0"}; !slices.Equal(got, want) {
+		t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, want)
+	}
+
+	if got := vs.Filter(func(semver.Version) bool { return false }); len(got) != 0 {
+		t.Errorf("Filter rejecting everything returned %v", got)
+	}
+}
+
+func TestVersionsLatest(t *testing.T) {
+	tests := []struct {
+		name              string
+		want              string
+		versions          []string
+		includePrerelease bool
+		ok                bool
+	}{
+		{name: "empty", versions: nil, ok: false},
+		{name: "only prereleases", versions: []string{"1.0.0-rc.1"}, ok: false},
+		{name: "only prereleases included", versions: []string{"1.0.0-rc.1"}, includePrerelease: true, ok: true, want: "1.0.0-rc.1"},
+		{name: "stable", versions: []string{"1.2.0", "2.0.0-rc.1", "1.10.0", "0.9.0"}, ok: true, want: "1.10.0"},
+		{name: "prerelease", versions: []string{"1.2.0", "2.0.0-rc.1", "1.10.0", "0.9.0"}, includePrerelease: true, ok: true, want: "2.0.0-rc.1"},
+		{name: "ties keep first", versions: []string{"1.0.0+b", "1.0.0+a"}, ok: true, want: "1.0.0+b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vs := mustParseAll(t, tt.versions...)
+
+			got, ok := vs.Latest(tt.includePrerelease)
+			if ok != tt.ok {
+				t.Fatalf("wrong ok: got %v, wanted %v", ok, tt.ok)
+			}
+
+			if ok && got.String() != tt.want {
+				t.Errorf("got %s, wanted %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionsLatestPer(t *testing.T) {
+	vs := mustParseAll(t,
+		"0.1.0", "0.2.3", "1.0.0", "1.2.0", "1.2.5+a", "1.2.5+b", "1.3.0-rc.1", "2.0.0-beta", "2.1.0-rc.1", "1.1.9",
+	)
+
+	tests := []struct {
+		latest            func(semver.Versions, bool) semver.Versions
+		name              string
+		want              []string
+		includePrerelease bool
+	}{
+		{
+			name:   "major",
+			latest: semver.Versions.LatestPerMajor,
+			want:   []string{"0.2.3", "1.2.5+a"},
+		},
+		{
+			name:              "major with prereleases",
+			latest:            semver.Versions.LatestPerMajor,
+			includePrerelease: true,
+			want:              []string{"0.2.3", "1.3.0-rc.1", "2.1.0-rc.1"},
+		},
+		{
+			name:   "minor",
+			latest: semver.Versions.LatestPerMinor,
+			want:   []string{"0.1.0", "0.2.3", "1.0.0", "1.1.9", "1.2.5+a"},
+		},
+		{
+			name:              "minor with prereleases",
+			latest:            semver.Versions.LatestPerMinor,
+			includePrerelease: true,
+			want:              []string{"0.1.0", "0.2.3", "1.0.0", "1.1.9", "1.2.5+a", "1.3.0-rc.1", "2.0.0-beta", "2.1.0-rc.1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := versionStrings(tt.latest(vs, tt.includePrerelease)); !slices.Equal(got, tt.want) {
+				t.Errorf("\nGot:\t%v\nWanted:\t%v\n", got, tt.want)
+			}
+		})
+	}
+
+	if got := semver.Versions(nil).LatestPerMajor(true); len(got) != 0 {
+		t.Errorf("LatestPerMajor of nil returned %v", got)
+	}
+}
+
+func ExampleVersions() {
+	var tags semver.Versions
+	for _, tag := range []string{"v1.4.0", "v1.3.2", "v2.0.0-rc.1", "v1.4.1", "v1.3.10", "not-a-version"} {
+		v, err := semver.Parse(tag)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, v)
+	}
+
+	latest, _ := tags.Latest(false)
+	fmt.Println("latest stable:", latest)
+	fmt.Println("supported lines:", tags.LatestPerMinor(false))
+
+	// Output:
+	// latest stable: 1.4.1
+	// supported lines: [1.3.10 1.4.1]
+}